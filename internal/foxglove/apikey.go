@@ -1,8 +1,11 @@
 package foxglove
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
+	"net/http"
 	"net/url"
 )
 
@@ -20,8 +23,28 @@ type ListAPIKeyResponse struct {
 }
 
 // ListAPIKeys fetches a list of API keys.
-func (c *Client) ListAPIKeys() ([]ListAPIKeyResponse, error) {
-	resp, err := c.doRequest("GET", "/api-keys", nil)
+func (c *Client) ListAPIKeys(ctx context.Context) ([]ListAPIKeyResponse, error) {
+	return c.listAPIKeys(ctx, url.Values{})
+}
+
+// AllAPIKeys returns an iterator over every API key, following limit/offset
+// pagination until all pages have been read.
+func (c *Client) AllAPIKeys(ctx context.Context, opts PageOptions) iter.Seq2[ListAPIKeyResponse, error] {
+	return paginate(ctx, opts, func(item ListAPIKeyResponse) string { return item.ID }, func(ctx context.Context, limit int, offset int) ([]ListAPIKeyResponse, error) {
+		params := url.Values{}
+		params.Add("limit", fmt.Sprintf("%d", limit))
+		params.Add("offset", fmt.Sprintf("%d", offset))
+		return c.listAPIKeys(ctx, params)
+	})
+}
+
+func (c *Client) listAPIKeys(ctx context.Context, params url.Values) ([]ListAPIKeyResponse, error) {
+	reqURL := "/api-keys"
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, "GET", reqURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -35,6 +58,26 @@ func (c *Client) ListAPIKeys() ([]ListAPIKeyResponse, error) {
 	return apiKeys, nil
 }
 
+// GetAPIKey retrieves a specific API key by its ID. The API has no endpoint
+// for a single key, so the key is looked up in the list of all keys.
+func (c *Client) GetAPIKey(ctx context.Context, id string) (*ListAPIKeyResponse, error) {
+	for apiKey, err := range c.AllAPIKeys(ctx, PageOptions{}) {
+		if err != nil {
+			return nil, err
+		}
+		if apiKey.ID == id {
+			return &apiKey, nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("API key %s not found", id),
+		Method:     "GET",
+		URL:        c.BaseURL + "/api-keys",
+	}
+}
+
 // CreateAPIKeyRequest represents the payload to create a new API key.
 type CreateAPIKeyRequest struct {
 	Label        string   `json:"label"`
@@ -54,8 +97,8 @@ type CreateAPIKeyResponse struct {
 }
 
 // CreateAPIKey creates a new API key with the specified label and capabilities.
-func (c *Client) CreateAPIKey(reqBody CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
-	resp, err := c.doRequest("POST", "/api-keys", reqBody)
+func (c *Client) CreateAPIKey(ctx context.Context, reqBody CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	resp, err := c.doRequest(ctx, "POST", "/api-keys", reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +116,7 @@ func (c *Client) CreateAPIKey(reqBody CreateAPIKeyRequest) (*CreateAPIKeyRespons
 type UpdateAPIKeyRequest struct {
 	Label        string   `json:"label,omitempty"`
 	Capabilities []string `json:"capabilities,omitempty"`
+	Enabled      *bool    `json:"enabled,omitempty"`
 }
 
 // UpdateAPIKeyResponse represents the response returned when updating an API key.
@@ -88,12 +132,12 @@ type UpdateAPIKeyResponse struct {
 }
 
 // UpdateAPIKey updates the details of a specific API key by its ID.
-func (c *Client) UpdateAPIKey(id string, reqBody UpdateAPIKeyRequest) (*UpdateAPIKeyResponse, error) {
+func (c *Client) UpdateAPIKey(ctx context.Context, id string, reqBody UpdateAPIKeyRequest) (*UpdateAPIKeyResponse, error) {
 	encodedID := url.PathEscape(id)
 
 	reqURL := fmt.Sprintf("/api-keys/%s", encodedID)
 
-	resp, err := c.doRequest("PATCH", reqURL, reqBody)
+	resp, err := c.doRequest(ctx, "PATCH", reqURL, reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -108,12 +152,12 @@ func (c *Client) UpdateAPIKey(id string, reqBody UpdateAPIKeyRequest) (*UpdateAP
 }
 
 // DeleteAPIKey deletes an API key by its ID.
-func (c *Client) DeleteAPIKey(id string) error {
+func (c *Client) DeleteAPIKey(ctx context.Context, id string) error {
 	encodedID := url.PathEscape(id)
 
 	reqURL := fmt.Sprintf("/api-keys/%s", encodedID)
 
-	resp, err := c.doRequest("DELETE", reqURL, nil)
+	resp, err := c.doRequest(ctx, "DELETE", reqURL, nil)
 	if err != nil {
 		return err
 	}
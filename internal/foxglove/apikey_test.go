@@ -1,11 +1,17 @@
 package foxglove
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
 	"testing"
 	"time"
 )
 
 func TestAPIKeyLifecycle(t *testing.T) {
+	ctx := context.Background()
 	client := NewClient("") // enter api key here
 
 	// Step 1: Create a new API key
@@ -18,7 +24,7 @@ func TestAPIKeyLifecycle(t *testing.T) {
 		},
 	}
 
-	createResp, err := client.CreateAPIKey(createReq)
+	createResp, err := client.CreateAPIKey(ctx, createReq)
 	if err != nil {
 		t.Fatalf("Failed to create API key: %v", err)
 		return
@@ -32,7 +38,7 @@ func TestAPIKeyLifecycle(t *testing.T) {
 	t.Logf("Created API key with ID: %s", createdAPIKeyID)
 
 	// Step 2: List API keys and verify the created API key exists
-	apiKeys, err := client.ListAPIKeys()
+	apiKeys, err := client.ListAPIKeys(ctx)
 	if err != nil {
 		t.Fatalf("Failed to list API keys: %v", err)
 		return
@@ -55,7 +61,7 @@ func TestAPIKeyLifecycle(t *testing.T) {
 
 	// Step 3: Change the name of the API key
 	apiKeyName = apiKeyName + "_updated"
-	updateResp, err := client.UpdateAPIKey(createdAPIKeyID, UpdateAPIKeyRequest{
+	updateResp, err := client.UpdateAPIKey(ctx, createdAPIKeyID, UpdateAPIKeyRequest{
 		Label:        apiKeyName,
 		Capabilities: []string{"recordings.list", "data.topics.list"},
 	})
@@ -71,7 +77,7 @@ func TestAPIKeyLifecycle(t *testing.T) {
 	t.Log("Successfully updated the API key name")
 
 	// Step 4: Delete the API key
-	err = client.DeleteAPIKey(createdAPIKeyID)
+	err = client.DeleteAPIKey(ctx, createdAPIKeyID)
 	if err != nil {
 		t.Fatalf("Failed to delete API key: %v", err)
 		return
@@ -82,7 +88,7 @@ func TestAPIKeyLifecycle(t *testing.T) {
 	// Optional Step 6: Verify the API key is no longer listed
 	time.Sleep(2 * time.Second) // Allow some time for the deletion to propagate
 
-	apiKeys, err = client.ListAPIKeys()
+	apiKeys, err = client.ListAPIKeys(ctx)
 	if err != nil {
 		t.Fatalf("Failed to list API keys after deletion: %v", err)
 		return
@@ -96,3 +102,37 @@ func TestAPIKeyLifecycle(t *testing.T) {
 
 	t.Log("Verified that the deleted API key no longer exists in the list")
 }
+
+// apiKeyListHandler serves count API keys, honoring limit and offset.
+func apiKeyListHandler(count int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		apiKeys := []ListAPIKeyResponse{}
+		for i := offset; i < offset+limit && i < count; i++ {
+			apiKeys = append(apiKeys, ListAPIKeyResponse{ID: fmt.Sprintf("key_%d", i), Label: fmt.Sprintf("key %d", i)})
+		}
+		json.NewEncoder(w).Encode(apiKeys)
+	}
+}
+
+func TestGetAPIKey(t *testing.T) {
+	client := newTestClient(t, apiKeyListHandler(150))
+
+	apiKey, err := client.GetAPIKey(context.Background(), "key_120")
+	if err != nil {
+		t.Fatalf("Failed to get API key: %v", err)
+	}
+	if apiKey.ID != "key_120" || apiKey.Label != "key 120" {
+		t.Fatalf("Unexpected API key %+v", apiKey)
+	}
+}
+
+func TestGetAPIKeyNotFound(t *testing.T) {
+	client := newTestClient(t, apiKeyListHandler(150))
+
+	_, err := client.GetAPIKey(context.Background(), "key_missing")
+	if !IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+}
@@ -1,64 +1,129 @@
 package foxglove
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"net/http/httputil"
+	"net/url"
+	"os"
 	"strings"
+	"time"
 )
 
+// DefaultBaseURL is the Foxglove API endpoint used unless another one is configured.
+const DefaultBaseURL = "https://api.foxglove.dev/v1"
+
 // Client represents the API client.
 type Client struct {
-	BaseURL string
-	APIKey  string
-	Client  *http.Client
+	BaseURL     string
+	APIKey      string
+	Client      *http.Client
+	RetryPolicy RetryPolicy
+	// UserAgent is sent with every request if set.
+	UserAgent string
+	// Timeout limits every attempt of an API request, including reading its
+	// response. Zero means no limit. Uploads and downloads of files through
+	// signed links are not limited, since their duration depends on the size.
+	Timeout time.Duration
 }
 
-type loggingTransport struct{}
-
-func (s *loggingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
-	bytes, _ := httputil.DumpRequestOut(r, true)
-
-	resp, err := http.DefaultTransport.RoundTrip(r)
-	// err is returned after dumping the response
-
-	respBytes, _ := httputil.DumpResponse(resp, true)
-	bytes = append(bytes, respBytes...)
-
-	fmt.Printf("%s\n", bytes)
-
-	return resp, err
+// Config holds the settings used by NewClientWithConfig.
+type Config struct {
+	APIKey string
+	// BaseURL defaults to DefaultBaseURL.
+	BaseURL string
+	// Timeout sets Client.Timeout. Zero means no limit.
+	Timeout time.Duration
+	// InsecureSkipVerify disables TLS certificate verification.
+	InsecureSkipVerify bool
+	// CACertFile is a PEM file with certificates trusted in addition to the system pool.
+	CACertFile string
+	// ProxyURL overrides the proxy taken from the HTTP_PROXY/HTTPS_PROXY environment variables.
+	ProxyURL  string
+	UserAgent string
 }
 
 // NewClient initializes and returns a new API client.
 func NewClient(apiKey string) *Client {
 	return &Client{
-		BaseURL: "https://api.foxglove.dev/v1", // Replace with actual base URL
+		BaseURL: DefaultBaseURL,
 		APIKey:  apiKey,
 		Client: &http.Client{
 			Transport: &loggingTransport{},
 		},
+		RetryPolicy: DefaultRetryPolicy(),
 	}
 }
 
-func (c *Client) doRequest(method string, uri string, reqBody interface{}) (*http.Response, error) {
-	url := c.BaseURL + uri
-	var body io.Reader = nil
+// NewClientWithConfig initializes an API client with custom endpoint and HTTP settings.
+func NewClientWithConfig(cfg Config) (*Client, error) {
+	client := NewClient(cfg.APIKey)
+	client.UserAgent = cfg.UserAgent
+	client.Timeout = cfg.Timeout
+
+	if cfg.BaseURL != "" {
+		baseURL, err := url.Parse(cfg.BaseURL)
+		if err != nil || (baseURL.Scheme != "http" && baseURL.Scheme != "https") || baseURL.Host == "" {
+			return nil, fmt.Errorf("invalid base URL %q: expected an absolute http or https URL", cfg.BaseURL)
+		}
+		client.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CACertFile != "" {
+		pem, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM encoded certificates found in %s", cfg.CACertFile)
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", cfg.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	client.Client = &http.Client{
+		Transport: &loggingTransport{next: transport},
+	}
+	return client, nil
+}
+
+// doRequest sends a request to the Foxglove API. The request is bound to ctx, so
+// cancelling the context or hitting its deadline aborts the call in flight.
+// Transient failures are retried according to the client's RetryPolicy.
+func (c *Client) doRequest(ctx context.Context, method string, uri string, reqBody interface{}) (*http.Response, error) {
+	req := request{method: method, path: uri}
 	if reqBody != nil {
 		reqBodyJSON, err := json.Marshal(reqBody)
 		if err != nil {
 			return nil, err
 		}
-		body = strings.NewReader(string(reqBodyJSON))
-	}
-	req, err := http.NewRequest(method, url, body)
-
-	if err != nil {
-		return nil, err
+		req.body = bytesBody(reqBodyJSON)
+		req.contentType = "application/json"
 	}
+	return c.do(ctx, req)
+}
 
+// authorize adds the API key to req, either as bearer token or as session cookie.
+func (c *Client) authorize(req *http.Request) {
 	if strings.HasPrefix(c.APIKey, "fox.session=") {
 		// this is for when you authenticate using a session cookie
 		req.AddCookie(&http.Cookie{
@@ -68,20 +133,4 @@ func (c *Client) doRequest(method string, uri string, reqBody interface{}) (*htt
 	} else {
 		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.APIKey))
 	}
-
-	if reqBody != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
-
-	resp, err := c.Client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		defer resp.Body.Close()
-		respBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to send request to %s: status code %d %s", url, resp.StatusCode, string(respBytes))
-	}
-	return resp, nil
 }
@@ -0,0 +1,52 @@
+package foxglove
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewClientWithConfig(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/api-keys" {
+			t.Errorf("Unexpected request path %s", r.URL.Path)
+		}
+		userAgent = r.Header.Get("User-Agent")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithConfig(Config{
+		APIKey:    "test",
+		BaseURL:   server.URL + "/v1/",
+		UserAgent: "terraform-provider-foxglove-cloud/test ci",
+		Timeout:   time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.ListAPIKeys(context.Background()); err != nil {
+		t.Fatalf("Failed to list API keys: %v", err)
+	}
+	if userAgent != "terraform-provider-foxglove-cloud/test ci" {
+		t.Fatalf("Unexpected user agent %q", userAgent)
+	}
+}
+
+func TestNewClientWithConfigInvalid(t *testing.T) {
+	configs := map[string]Config{
+		"relative base URL": {BaseURL: "api.foxglove.dev/v1"},
+		"missing CA file":   {CACertFile: "does-not-exist.pem"},
+		"invalid proxy URL": {ProxyURL: "://proxy"},
+	}
+
+	for name, config := range configs {
+		if _, err := NewClientWithConfig(config); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
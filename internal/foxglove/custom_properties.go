@@ -0,0 +1,136 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Value types of a custom property.
+const (
+	PropertyTypeString  = "string"
+	PropertyTypeNumber  = "number"
+	PropertyTypeBoolean = "boolean"
+	PropertyTypeEnum    = "enum"
+)
+
+// CustomPropertyResourceDevice is the resource type of device properties.
+const CustomPropertyResourceDevice = "device"
+
+// CustomPropertyResponse represents the definition of a custom property.
+type CustomPropertyResponse struct {
+	ID           string   `json:"id"`
+	Key          string   `json:"key"`
+	Label        string   `json:"label"`
+	ResourceType string   `json:"resourceType"`
+	ValueType    string   `json:"valueType"`
+	Values       []string `json:"values"`
+}
+
+// ListCustomProperties fetches the custom properties defined for a resource
+// type, or for all resource types if resourceType is empty.
+func (c *Client) ListCustomProperties(ctx context.Context, resourceType string) ([]CustomPropertyResponse, error) {
+	reqURL := "/custom-properties"
+	if resourceType != "" {
+		reqURL += "?" + url.Values{"resourceType": {resourceType}}.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var properties []CustomPropertyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&properties); err != nil {
+		return nil, err
+	}
+
+	return properties, nil
+}
+
+// GetCustomProperty retrieves a specific custom property by its ID. The API
+// has no endpoint for a single property, so the property is looked up in the
+// list of all properties.
+func (c *Client) GetCustomProperty(ctx context.Context, id string) (*CustomPropertyResponse, error) {
+	properties, err := c.ListCustomProperties(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, property := range properties {
+		if property.ID == id {
+			return &property, nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("custom property %s not found", id),
+		Method:     "GET",
+		URL:        c.BaseURL + "/custom-properties",
+	}
+}
+
+// CreateCustomPropertyRequest represents the payload to define a new custom property.
+type CreateCustomPropertyRequest struct {
+	Key          string   `json:"key"`
+	Label        string   `json:"label"`
+	ResourceType string   `json:"resourceType"`
+	ValueType    string   `json:"valueType"`
+	Values       []string `json:"values,omitempty"`
+}
+
+// CreateCustomProperty defines a new custom property.
+func (c *Client) CreateCustomProperty(ctx context.Context, reqBody CreateCustomPropertyRequest) (*CustomPropertyResponse, error) {
+	resp, err := c.doRequest(ctx, "POST", "/custom-properties", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var property CustomPropertyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&property); err != nil {
+		return nil, err
+	}
+
+	return &property, nil
+}
+
+// UpdateCustomPropertyRequest represents the payload to update a custom property.
+type UpdateCustomPropertyRequest struct {
+	Label  string   `json:"label,omitempty"`
+	Values []string `json:"values,omitempty"`
+}
+
+// UpdateCustomProperty updates a specific custom property by its ID.
+func (c *Client) UpdateCustomProperty(ctx context.Context, id string, reqBody UpdateCustomPropertyRequest) (*CustomPropertyResponse, error) {
+	reqURL := fmt.Sprintf("/custom-properties/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "PATCH", reqURL, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var property CustomPropertyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&property); err != nil {
+		return nil, err
+	}
+
+	return &property, nil
+}
+
+// DeleteCustomProperty deletes a custom property by its ID.
+func (c *Client) DeleteCustomProperty(ctx context.Context, id string) error {
+	reqURL := fmt.Sprintf("/custom-properties/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "DELETE", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package foxglove
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetCustomProperty(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/custom-properties" {
+			t.Errorf("Unexpected request path %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"id":"cp_1","key":"stage","resourceType":"device","valueType":"enum","values":["dev","prod"]}]`))
+	})
+
+	property, err := client.GetCustomProperty(context.Background(), "cp_1")
+	if err != nil {
+		t.Fatalf("Failed to get custom property: %v", err)
+	}
+	if property.Key != "stage" || len(property.Values) != 2 {
+		t.Fatalf("Unexpected custom property %+v", property)
+	}
+
+	if _, err := client.GetCustomProperty(context.Background(), "cp_missing"); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+}
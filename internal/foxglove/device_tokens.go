@@ -0,0 +1,122 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// DeviceTokenResponse represents a token a device authenticates with.
+type DeviceTokenResponse struct {
+	ID        string `json:"id"`
+	DeviceID  string `json:"deviceId"`
+	Enabled   bool   `json:"enabled"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// ListDeviceTokens fetches the tokens of a device, or of all devices if deviceID is empty.
+func (c *Client) ListDeviceTokens(ctx context.Context, deviceID string) ([]DeviceTokenResponse, error) {
+	reqURL := "/device-tokens"
+	if deviceID != "" {
+		reqURL += "?" + url.Values{"deviceId": {deviceID}}.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var tokens []DeviceTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// GetDeviceToken retrieves the details of a specific device token by its ID.
+func (c *Client) GetDeviceToken(ctx context.Context, id string) (*DeviceTokenResponse, error) {
+	reqURL := fmt.Sprintf("/device-tokens/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var token DeviceTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// CreateDeviceTokenRequest represents the payload to create a new device token.
+type CreateDeviceTokenRequest struct {
+	DeviceID string `json:"deviceId"`
+}
+
+// CreateDeviceTokenResponse represents the response returned after creating a
+// device token. The token is only returned once.
+type CreateDeviceTokenResponse struct {
+	ID        string `json:"id"`
+	DeviceID  string `json:"deviceId"`
+	Enabled   bool   `json:"enabled"`
+	CreatedAt string `json:"createdAt"`
+	Token     string `json:"token"`
+}
+
+// CreateDeviceToken creates a new token for a device.
+func (c *Client) CreateDeviceToken(ctx context.Context, reqBody CreateDeviceTokenRequest) (*CreateDeviceTokenResponse, error) {
+	resp, err := c.doRequest(ctx, "POST", "/device-tokens", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var token CreateDeviceTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// UpdateDeviceTokenRequest represents the payload to update a device token.
+type UpdateDeviceTokenRequest struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// UpdateDeviceToken updates a specific device token by its ID.
+func (c *Client) UpdateDeviceToken(ctx context.Context, id string, reqBody UpdateDeviceTokenRequest) (*DeviceTokenResponse, error) {
+	reqURL := fmt.Sprintf("/device-tokens/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "PATCH", reqURL, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var token DeviceTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// DeleteDeviceToken deletes a device token by its ID.
+func (c *Client) DeleteDeviceToken(ctx context.Context, id string) error {
+	reqURL := fmt.Sprintf("/device-tokens/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "DELETE", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestUpdateDeviceToken(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/device-tokens/dt_1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body UpdateDeviceTokenRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if body.Enabled == nil || *body.Enabled {
+			t.Errorf("Expected enabled=false to be sent, got %+v", body)
+		}
+		json.NewEncoder(w).Encode(DeviceTokenResponse{ID: "dt_1", DeviceID: "dev_1", Enabled: false})
+	})
+
+	enabled := false
+	token, err := client.UpdateDeviceToken(context.Background(), "dt_1", UpdateDeviceTokenRequest{Enabled: &enabled})
+	if err != nil {
+		t.Fatalf("Failed to update device token: %v", err)
+	}
+	if token.Enabled || token.DeviceID != "dev_1" {
+		t.Fatalf("Unexpected device token %+v", token)
+	}
+}
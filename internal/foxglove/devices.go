@@ -1,24 +1,57 @@
 package foxglove
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"time"
 )
 
+// DeviceProperties holds the custom properties of a device. Typed property
+// values, such as numbers and booleans, are kept in their JSON spelling.
+type DeviceProperties map[string]string
+
+// UnmarshalJSON decodes properties of any scalar type. Null values are skipped.
+func (p *DeviceProperties) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		*p = nil
+		return nil
+	}
+
+	properties := DeviceProperties{}
+	for key, value := range raw {
+		var text string
+		switch {
+		case string(value) == "null":
+			continue
+		case json.Unmarshal(value, &text) == nil:
+			properties[key] = text
+		default:
+			properties[key] = string(value)
+		}
+	}
+	*p = properties
+	return nil
+}
+
 // ListDeviceResponse represents a robot device.
 type ListDeviceResponse struct {
-	ID         string            `json:"id"`
-	Name       string            `json:"name"`
-	OrgID      string            `json:"orgId"`
-	CreatedAt  string            `json:"createdAt"`
-	UpdatedAt  time.Time         `json:"updatedAt"`
-	Properties map[string]string `json:"properties"`
+	ID         string           `json:"id"`
+	Name       string           `json:"name"`
+	OrgID      string           `json:"orgId"`
+	CreatedAt  string           `json:"createdAt"`
+	UpdatedAt  time.Time        `json:"updatedAt"`
+	Properties DeviceProperties `json:"properties"`
 }
 
 // ListDevices fetches a list of devices with optional query parameters.
-func (c *Client) ListDevices(query string, sortBy string, sortOrder string, limit int, offset int) ([]ListDeviceResponse, error) {
+func (c *Client) ListDevices(ctx context.Context, query string, sortBy string, sortOrder string, limit int, offset int) ([]ListDeviceResponse, error) {
 	params := url.Values{}
 	if query != "" {
 		params.Add("query", query)
@@ -36,7 +69,7 @@ func (c *Client) ListDevices(query string, sortBy string, sortOrder string, limi
 		params.Add("offset", fmt.Sprintf("%d", offset))
 	}
 
-	resp, err := c.doRequest("GET", "/devices?"+params.Encode(), nil)
+	resp, err := c.doRequest(ctx, "GET", "/devices?"+params.Encode(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -50,25 +83,40 @@ func (c *Client) ListDevices(query string, sortBy string, sortOrder string, limi
 	return devices, nil
 }
 
+// DeviceFilter narrows down the devices returned by AllDevices.
+type DeviceFilter struct {
+	Query     string
+	SortBy    string
+	SortOrder string
+}
+
+// AllDevices returns an iterator over every device matching filter, following
+// limit/offset pagination until all pages have been read.
+func (c *Client) AllDevices(ctx context.Context, filter DeviceFilter, opts PageOptions) iter.Seq2[ListDeviceResponse, error] {
+	return paginate(ctx, opts, func(item ListDeviceResponse) string { return item.ID }, func(ctx context.Context, limit int, offset int) ([]ListDeviceResponse, error) {
+		return c.ListDevices(ctx, filter.Query, filter.SortBy, filter.SortOrder, limit, offset)
+	})
+}
+
 // CreateDeviceRequest represents the payload to create a new device.
 type CreateDeviceRequest struct {
-	Name       string            `json:"name"`
-	Properties map[string]string `json:"properties,omitempty"`
+	Name       string                 `json:"name"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
 }
 
 // CreateDeviceResponse represents the response returned after creating a new device.
 type CreateDeviceResponse struct {
-	ID         string            `json:"id"`
-	Name       string            `json:"name"`
-	OrgID      string            `json:"orgId"`
-	CreatedAt  string            `json:"createdAt"`
-	UpdatedAt  time.Time         `json:"updatedAt"`
-	Properties map[string]string `json:"properties"`
+	ID         string           `json:"id"`
+	Name       string           `json:"name"`
+	OrgID      string           `json:"orgId"`
+	CreatedAt  string           `json:"createdAt"`
+	UpdatedAt  time.Time        `json:"updatedAt"`
+	Properties DeviceProperties `json:"properties"`
 }
 
 // CreateDevice creates a new device with the specified name and properties.
-func (c *Client) CreateDevice(reqBody CreateDeviceRequest) (*CreateDeviceResponse, error) {
-	resp, err := c.doRequest("POST", "/devices", reqBody)
+func (c *Client) CreateDevice(ctx context.Context, reqBody CreateDeviceRequest) (*CreateDeviceResponse, error) {
+	resp, err := c.doRequest(ctx, "POST", "/devices", reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -84,21 +132,21 @@ func (c *Client) CreateDevice(reqBody CreateDeviceRequest) (*CreateDeviceRespons
 
 // GetDeviceResponse represents the response returned when retrieving a specific device.
 type GetDeviceResponse struct {
-	ID         string            `json:"id"`
-	Name       string            `json:"name"`
-	OrgID      string            `json:"orgId"`
-	CreatedAt  string            `json:"createdAt"`
-	UpdatedAt  time.Time         `json:"updatedAt"`
-	Properties map[string]string `json:"properties"`
+	ID         string           `json:"id"`
+	Name       string           `json:"name"`
+	OrgID      string           `json:"orgId"`
+	CreatedAt  string           `json:"createdAt"`
+	UpdatedAt  time.Time        `json:"updatedAt"`
+	Properties DeviceProperties `json:"properties"`
 }
 
 // GetDevice retrieves the details of a specific device by its name or ID.
-func (c *Client) GetDevice(nameOrId string) (*GetDeviceResponse, error) {
+func (c *Client) GetDevice(ctx context.Context, nameOrId string) (*GetDeviceResponse, error) {
 	encodedNameOrId := url.PathEscape(nameOrId)
 
 	reqURL := fmt.Sprintf("/devices/%s", encodedNameOrId)
 
-	resp, err := c.doRequest("GET", reqURL, nil)
+	resp, err := c.doRequest(ctx, "GET", reqURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -120,21 +168,21 @@ type UpdateDeviceRequest struct {
 
 // UpdateDeviceResponse represents the response returned when updating a device.
 type UpdateDeviceResponse struct {
-	ID         string                 `json:"id"`
-	Name       string                 `json:"name"`
-	OrgID      string                 `json:"orgId"`
-	CreatedAt  string                 `json:"createdAt"`
-	UpdatedAt  time.Time              `json:"updatedAt"`
-	Properties map[string]interface{} `json:"properties"`
+	ID         string           `json:"id"`
+	Name       string           `json:"name"`
+	OrgID      string           `json:"orgId"`
+	CreatedAt  string           `json:"createdAt"`
+	UpdatedAt  time.Time        `json:"updatedAt"`
+	Properties DeviceProperties `json:"properties"`
 }
 
 // UpdateDevice updates the details of a specific device by its name or ID.
-func (c *Client) UpdateDevice(nameOrId string, reqBody UpdateDeviceRequest) (*UpdateDeviceResponse, error) {
+func (c *Client) UpdateDevice(ctx context.Context, nameOrId string, reqBody UpdateDeviceRequest) (*UpdateDeviceResponse, error) {
 	encodedNameOrId := url.PathEscape(nameOrId)
 
 	reqURL := fmt.Sprintf("/devices/%s", encodedNameOrId)
 
-	resp, err := c.doRequest("PATCH", reqURL, reqBody)
+	resp, err := c.doRequest(ctx, "PATCH", reqURL, reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -154,12 +202,12 @@ type DeleteDeviceResponse struct {
 }
 
 // DeleteDevice deletes a device by its name or ID.
-func (c *Client) DeleteDevice(nameOrId string) (*DeleteDeviceResponse, error) {
+func (c *Client) DeleteDevice(ctx context.Context, nameOrId string) (*DeleteDeviceResponse, error) {
 	encodedNameOrId := url.PathEscape(nameOrId)
 
 	reqURL := fmt.Sprintf("/devices/%s", encodedNameOrId)
 
-	resp, err := c.doRequest("DELETE", reqURL, nil)
+	resp, err := c.doRequest(ctx, "DELETE", reqURL, nil)
 	if err != nil {
 		return nil, err
 	}
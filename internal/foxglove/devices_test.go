@@ -1,11 +1,14 @@
 package foxglove
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 	"time"
 )
 
 func TestDeviceLifecycle(t *testing.T) {
+	ctx := context.Background()
 	client := NewClient("") // enter api key here
 
 	// Step 1: Create a new device
@@ -14,7 +17,7 @@ func TestDeviceLifecycle(t *testing.T) {
 		Name: deviceName,
 	}
 
-	createResp, err := client.CreateDevice(createReq)
+	createResp, err := client.CreateDevice(ctx, createReq)
 	if err != nil {
 		t.Fatalf("Failed to create device: %v", err)
 		return
@@ -28,7 +31,7 @@ func TestDeviceLifecycle(t *testing.T) {
 	t.Logf("Created device with ID: %s", createdDeviceID)
 
 	// Step 2: List devices and verify the created device exists
-	devices, err := client.ListDevices("", "", "", 100, 0)
+	devices, err := client.ListDevices(ctx, "", "", "", 100, 0)
 	if err != nil {
 		t.Fatalf("Failed to list devices: %v", err)
 		return
@@ -49,7 +52,7 @@ func TestDeviceLifecycle(t *testing.T) {
 	t.Log("Verified that the created device exists in the list")
 
 	// Step 3: Retrieve the device by ID
-	getResp, err := client.GetDevice(createdDeviceID)
+	getResp, err := client.GetDevice(ctx, createdDeviceID)
 	if err != nil {
 		t.Fatalf("Failed to retrieve device: %v", err)
 		return
@@ -64,7 +67,7 @@ func TestDeviceLifecycle(t *testing.T) {
 
 	// Step 4: Change the name of the device
 	deviceName = deviceName + "_updated"
-	updateResp, err := client.UpdateDevice(createdDeviceID, UpdateDeviceRequest{
+	updateResp, err := client.UpdateDevice(ctx, createdDeviceID, UpdateDeviceRequest{
 		Name: deviceName,
 	})
 	if err != nil {
@@ -79,7 +82,7 @@ func TestDeviceLifecycle(t *testing.T) {
 	t.Log("Successfully updated the device name")
 
 	// Step 5: Delete the device
-	deleteResp, err := client.DeleteDevice(createdDeviceID)
+	deleteResp, err := client.DeleteDevice(ctx, createdDeviceID)
 	if err != nil {
 		t.Fatalf("Failed to delete device: %v", err)
 		return
@@ -94,7 +97,7 @@ func TestDeviceLifecycle(t *testing.T) {
 	// Optional Step 6: Verify the device is no longer listed
 	time.Sleep(2 * time.Second) // Allow some time for the deletion to propagate
 
-	devices, err = client.ListDevices("", "", "", 100, 0)
+	devices, err = client.ListDevices(ctx, "", "", "", 100, 0)
 	if err != nil {
 		t.Fatalf("Failed to list devices after deletion: %v", err)
 	}
@@ -107,3 +110,21 @@ func TestDeviceLifecycle(t *testing.T) {
 
 	t.Log("Verified that the deleted device no longer exists in the list")
 }
+
+func TestDevicePropertiesUnmarshal(t *testing.T) {
+	var device GetDeviceResponse
+	body := `{"id":"dev_1","properties":{"site":"munich","payload_kg":12.50,"lidar":true,"removed":null}}`
+	if err := json.Unmarshal([]byte(body), &device); err != nil {
+		t.Fatalf("Failed to decode device: %v", err)
+	}
+
+	expected := map[string]string{"site": "munich", "payload_kg": "12.50", "lidar": "true"}
+	if len(device.Properties) != len(expected) {
+		t.Fatalf("Expected properties %v, got %v", expected, device.Properties)
+	}
+	for key, value := range expected {
+		if device.Properties[key] != value {
+			t.Errorf("Expected %s=%q, got %q", key, value, device.Properties[key])
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package foxglove
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned when the Foxglove API answers with an unexpected status code.
+type APIError struct {
+	StatusCode int
+	// Code is the machine readable error code returned by Foxglove, if any.
+	Code string
+	// Message is the human readable error message returned by Foxglove. It
+	// falls back to the raw response body if the body is not a JSON error.
+	Message   string
+	RequestID string
+	Method    string
+	URL       string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s: status code %d", e.Method, e.URL, e.StatusCode)
+	if e.Code != "" {
+		msg += " (" + e.Code + ")"
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.RequestID != "" {
+		msg += " [request id " + e.RequestID + "]"
+	}
+	return msg
+}
+
+// newAPIError builds an APIError from a failed response and consumes its body.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Method:     resp.Request.Method,
+		URL:        resp.Request.URL.String(),
+	}
+
+	respBytes, _ := io.ReadAll(resp.Body)
+	var body struct {
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}
+	if err := json.Unmarshal(respBytes, &body); err == nil && (body.Error != "" || body.Code != "") {
+		apiErr.Message = body.Error
+		apiErr.Code = body.Code
+	} else {
+		apiErr.Message = strings.TrimSpace(string(respBytes))
+	}
+	return apiErr
+}
+
+func hasStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err is an API error caused by a missing object.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an API error caused by a conflicting object.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsUnauthorized reports whether err is an API error caused by a missing,
+// invalid or expired API key, or by a key lacking the required capability.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized) || hasStatus(err, http.StatusForbidden)
+}
@@ -0,0 +1,49 @@
+package foxglove
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req_123")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Device not found","code":"not-found"}`))
+	})
+
+	_, err := client.GetDevice(context.Background(), "missing")
+	if err == nil {
+		t.Fatal("Expected request to fail")
+	}
+
+	wrapped := fmt.Errorf("wrapped: %w", err)
+	if !IsNotFound(wrapped) || IsConflict(wrapped) || IsUnauthorized(wrapped) {
+		t.Fatalf("Expected a not found error, got: %v", err)
+	}
+
+	apiErr := err.(*APIError)
+	if apiErr.Code != "not-found" || apiErr.Message != "Device not found" || apiErr.RequestID != "req_123" {
+		t.Fatalf("Unexpected error details: %+v", apiErr)
+	}
+	if apiErr.Method != http.MethodGet || apiErr.URL != client.BaseURL+"/devices/missing" {
+		t.Fatalf("Unexpected request details: %+v", apiErr)
+	}
+}
+
+func TestAPIErrorPlainBody(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("Unauthorized\n"))
+	})
+
+	_, err := client.ListAPIKeys(context.Background())
+	if !IsUnauthorized(err) {
+		t.Fatalf("Expected an unauthorized error, got: %v", err)
+	}
+	if err.(*APIError).Message != "Unauthorized" {
+		t.Fatalf("Expected the response body as message, got %q", err.(*APIError).Message)
+	}
+}
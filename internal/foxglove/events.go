@@ -0,0 +1,172 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// EventResponse represents an event annotating a time range of a device.
+type EventResponse struct {
+	ID        string            `json:"id"`
+	DeviceID  string            `json:"deviceId"`
+	Device    *RecordingDevice  `json:"device"`
+	Start     string            `json:"start"`
+	End       string            `json:"end"`
+	Metadata  map[string]string `json:"metadata"`
+	CreatedAt string            `json:"createdAt"`
+	UpdatedAt string            `json:"updatedAt"`
+}
+
+// EventFilter narrows down the events returned by ListEvents and AllEvents.
+// Zero values are not sent.
+type EventFilter struct {
+	DeviceID   string
+	DeviceName string
+	Start      time.Time
+	End        time.Time
+	Query      string
+	SortBy     string
+	SortOrder  string
+}
+
+// ListEvents fetches a page of events matching filter.
+func (c *Client) ListEvents(ctx context.Context, filter EventFilter, limit int, offset int) ([]EventResponse, error) {
+	params := url.Values{}
+	if filter.DeviceID != "" {
+		params.Add("deviceId", filter.DeviceID)
+	}
+	if filter.DeviceName != "" {
+		params.Add("deviceName", filter.DeviceName)
+	}
+	if !filter.Start.IsZero() {
+		params.Add("start", filter.Start.UTC().Format(time.RFC3339Nano))
+	}
+	if !filter.End.IsZero() {
+		params.Add("end", filter.End.UTC().Format(time.RFC3339Nano))
+	}
+	if filter.Query != "" {
+		params.Add("query", filter.Query)
+	}
+	if filter.SortBy != "" {
+		params.Add("sortBy", filter.SortBy)
+	}
+	if filter.SortOrder != "" {
+		params.Add("sortOrder", filter.SortOrder)
+	}
+	if limit > 0 {
+		params.Add("limit", fmt.Sprintf("%d", limit))
+	}
+	if offset > 0 {
+		params.Add("offset", fmt.Sprintf("%d", offset))
+	}
+
+	resp, err := c.doRequest(ctx, "GET", "/events?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var events []EventResponse
+	if err := json.NewDecoder(resp.Body).Decode(&events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// AllEvents returns an iterator over every event matching filter, following
+// limit/offset pagination until all pages have been read.
+func (c *Client) AllEvents(ctx context.Context, filter EventFilter, opts PageOptions) iter.Seq2[EventResponse, error] {
+	return paginate(ctx, opts, func(item EventResponse) string { return item.ID }, func(ctx context.Context, limit int, offset int) ([]EventResponse, error) {
+		return c.ListEvents(ctx, filter, limit, offset)
+	})
+}
+
+// CreateEventRequest represents the payload to create a new event. Either
+// DeviceID or DeviceName identifies the device.
+type CreateEventRequest struct {
+	DeviceID   string            `json:"deviceId,omitempty"`
+	DeviceName string            `json:"deviceName,omitempty"`
+	Start      string            `json:"start"`
+	End        string            `json:"end"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+}
+
+// CreateEvent creates a new event.
+func (c *Client) CreateEvent(ctx context.Context, reqBody CreateEventRequest) (*EventResponse, error) {
+	resp, err := c.doRequest(ctx, "POST", "/events", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var event EventResponse
+	if err := json.NewDecoder(resp.Body).Decode(&event); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// GetEvent retrieves the details of a specific event by its ID.
+func (c *Client) GetEvent(ctx context.Context, id string) (*EventResponse, error) {
+	reqURL := fmt.Sprintf("/events/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var event EventResponse
+	if err := json.NewDecoder(resp.Body).Decode(&event); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// UpdateEventRequest represents the payload to update an event. Empty fields
+// are left unchanged, except Metadata which replaces all metadata of the event.
+type UpdateEventRequest struct {
+	DeviceID   string            `json:"deviceId,omitempty"`
+	DeviceName string            `json:"deviceName,omitempty"`
+	Start      string            `json:"start,omitempty"`
+	End        string            `json:"end,omitempty"`
+	Metadata   map[string]string `json:"metadata"`
+}
+
+// UpdateEvent updates a specific event by its ID.
+func (c *Client) UpdateEvent(ctx context.Context, id string, reqBody UpdateEventRequest) (*EventResponse, error) {
+	reqURL := fmt.Sprintf("/events/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "PATCH", reqURL, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var event EventResponse
+	if err := json.NewDecoder(resp.Body).Decode(&event); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// DeleteEvent deletes an event by its ID.
+func (c *Client) DeleteEvent(ctx context.Context, id string) error {
+	reqURL := fmt.Sprintf("/events/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "DELETE", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestUpdateEventReplacesMetadata(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/events/evt_1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if _, ok := body["deviceId"]; ok {
+			t.Errorf("Expected an empty device to be omitted, got %v", body)
+		}
+		if metadata, ok := body["metadata"].(map[string]interface{}); !ok || len(metadata) != 0 {
+			t.Errorf("Expected empty metadata to be sent, got %v", body)
+		}
+		end, _ := body["end"].(string)
+		json.NewEncoder(w).Encode(EventResponse{ID: "evt_1", End: end})
+	})
+
+	event, err := client.UpdateEvent(context.Background(), "evt_1", UpdateEventRequest{
+		End:      "2024-06-01T12:00:00Z",
+		Metadata: map[string]string{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update event: %v", err)
+	}
+	if event.End != "2024-06-01T12:00:00Z" {
+		t.Fatalf("Unexpected event %+v", event)
+	}
+}
@@ -0,0 +1,127 @@
+package foxglove
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// ExtensionResponse represents an extension published to the organization.
+type ExtensionResponse struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Publisher     string `json:"publisher"`
+	DisplayName   string `json:"displayName"`
+	Description   string `json:"description"`
+	ActiveVersion string `json:"activeVersion"`
+	Sha256Sum     string `json:"sha256Sum"`
+	CreatedAt     string `json:"createdAt"`
+	UpdatedAt     string `json:"updatedAt"`
+}
+
+// ListExtensions fetches the list of extensions.
+func (c *Client) ListExtensions(ctx context.Context) ([]ExtensionResponse, error) {
+	resp, err := c.doRequest(ctx, "GET", "/extensions", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var extensions []ExtensionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&extensions); err != nil {
+		return nil, err
+	}
+
+	return extensions, nil
+}
+
+// GetExtension retrieves a specific extension by its ID.
+func (c *Client) GetExtension(ctx context.Context, id string) (*ExtensionResponse, error) {
+	reqURL := fmt.Sprintf("/extensions/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var extension ExtensionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&extension); err != nil {
+		return nil, err
+	}
+
+	return &extension, nil
+}
+
+// UploadExtension uploads a .foxe extension package. Uploading a package of an
+// extension which already exists publishes it as the new active version.
+func (c *Client) UploadExtension(ctx context.Context, foxe []byte) (*ExtensionResponse, error) {
+	resp, err := c.do(ctx, request{
+		method:      "POST",
+		path:        "/extension-upload",
+		body:        bytesBody(foxe),
+		contentType: "application/octet-stream",
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var extension ExtensionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&extension); err != nil {
+		return nil, err
+	}
+
+	return &extension, nil
+}
+
+// DeleteExtension deletes an extension with all its versions by its ID.
+func (c *Client) DeleteExtension(ctx context.Context, id string) error {
+	reqURL := fmt.Sprintf("/extensions/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "DELETE", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ExtensionManifest is the package.json of a .foxe extension package.
+type ExtensionManifest struct {
+	Name        string `json:"name"`
+	Publisher   string `json:"publisher"`
+	Version     string `json:"version"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+}
+
+// ReadExtensionManifest reads the manifest of a .foxe extension package, which
+// is a zip archive with a package.json at its root.
+func ReadExtensionManifest(foxe []byte) (*ExtensionManifest, error) {
+	archive, err := zip.NewReader(bytes.NewReader(foxe), int64(len(foxe)))
+	if err != nil {
+		return nil, fmt.Errorf("not a .foxe extension package: %w", err)
+	}
+
+	file, err := archive.Open("package.json")
+	if err != nil {
+		return nil, errors.New("not a .foxe extension package: package.json is missing")
+	}
+	defer file.Close()
+
+	var manifest ExtensionManifest
+	if err := json.NewDecoder(file).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("invalid package.json: %w", err)
+	}
+	if manifest.Name == "" || manifest.Version == "" {
+		return nil, errors.New("invalid package.json: name and version are required")
+	}
+
+	return &manifest, nil
+}
@@ -0,0 +1,104 @@
+package foxglove
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func testExtensionPackage(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(content))
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestUploadExtensionSendsPackage(t *testing.T) {
+	foxe := testExtensionPackage(t, map[string]string{"package.json": `{"name":"panel","version":"1.0.0"}`})
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/extension-upload" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/octet-stream" {
+			t.Errorf("Unexpected content type %q", contentType)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if !bytes.Equal(body, foxe) {
+			t.Errorf("Unexpected request body")
+		}
+		w.Write([]byte(`{"id":"ext_1","name":"panel","activeVersion":"1.0.0"}`))
+	})
+
+	extension, err := client.UploadExtension(context.Background(), foxe)
+	if err != nil {
+		t.Fatalf("Failed to upload extension: %v", err)
+	}
+	if extension.ID != "ext_1" || extension.ActiveVersion != "1.0.0" {
+		t.Fatalf("Unexpected extension %+v", extension)
+	}
+}
+
+func TestUploadExtensionRetryResendsPackage(t *testing.T) {
+	foxe := testExtensionPackage(t, map[string]string{"package.json": `{"name":"panel","version":"1.0.0"}`})
+
+	attempts := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if !bytes.Equal(body, foxe) {
+			t.Errorf("Unexpected request body on attempt %d", attempts)
+		}
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id":"ext_1"}`))
+	})
+
+	if _, err := client.UploadExtension(WithRetrySafe(context.Background()), foxe); err != nil {
+		t.Fatalf("Failed to upload extension: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestReadExtensionManifest(t *testing.T) {
+	foxe := testExtensionPackage(t, map[string]string{
+		"package.json":  `{"name":"panel","publisher":"acme","version":"1.2.0","displayName":"Panel"}`,
+		"dist/index.js": "module.exports = {}",
+	})
+
+	manifest, err := ReadExtensionManifest(foxe)
+	if err != nil {
+		t.Fatalf("Failed to read manifest: %v", err)
+	}
+	if manifest.Name != "panel" || manifest.Publisher != "acme" || manifest.Version != "1.2.0" || manifest.DisplayName != "Panel" {
+		t.Fatalf("Unexpected manifest %+v", manifest)
+	}
+
+	for name, foxe := range map[string][]byte{
+		"not a zip":       []byte("hello"),
+		"no package.json": testExtensionPackage(t, map[string]string{"index.js": ""}),
+		"no version":      testExtensionPackage(t, map[string]string{"package.json": `{"name":"panel"}`}),
+	} {
+		if _, err := ReadExtensionManifest(foxe); err == nil {
+			t.Errorf("Expected an error for a package with %s", name)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Permissions of a layout.
+const (
+	LayoutPermissionCreatorWrite = "CREATOR_WRITE"
+	LayoutPermissionOrgRead      = "ORG_READ"
+	LayoutPermissionOrgWrite     = "ORG_WRITE"
+)
+
+// LayoutResponse represents a visualization layout.
+type LayoutResponse struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Permission string          `json:"permission"`
+	Data       json.RawMessage `json:"data"`
+	CreatedAt  string          `json:"createdAt"`
+	UpdatedAt  string          `json:"updatedAt"`
+}
+
+// ListLayouts fetches the list of layouts, without their data.
+func (c *Client) ListLayouts(ctx context.Context) ([]LayoutResponse, error) {
+	resp, err := c.doRequest(ctx, "GET", "/layouts", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var layouts []LayoutResponse
+	if err := json.NewDecoder(resp.Body).Decode(&layouts); err != nil {
+		return nil, err
+	}
+
+	return layouts, nil
+}
+
+// GetLayout retrieves a specific layout including its data by its ID.
+func (c *Client) GetLayout(ctx context.Context, id string) (*LayoutResponse, error) {
+	reqURL := fmt.Sprintf("/layouts/%s?includeData=true", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var layout LayoutResponse
+	if err := json.NewDecoder(resp.Body).Decode(&layout); err != nil {
+		return nil, err
+	}
+
+	return &layout, nil
+}
+
+// CreateLayoutRequest represents the payload to create a new layout.
+type CreateLayoutRequest struct {
+	Name       string          `json:"name"`
+	Permission string          `json:"permission"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// CreateLayout creates a new layout.
+func (c *Client) CreateLayout(ctx context.Context, reqBody CreateLayoutRequest) (*LayoutResponse, error) {
+	resp, err := c.doRequest(ctx, "POST", "/layouts", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var layout LayoutResponse
+	if err := json.NewDecoder(resp.Body).Decode(&layout); err != nil {
+		return nil, err
+	}
+
+	return &layout, nil
+}
+
+// UpdateLayoutRequest represents the payload to update a layout. Empty fields
+// are left unchanged.
+type UpdateLayoutRequest struct {
+	Name       string          `json:"name,omitempty"`
+	Permission string          `json:"permission,omitempty"`
+	Data       json.RawMessage `json:"data,omitempty"`
+}
+
+// UpdateLayout updates a specific layout by its ID.
+func (c *Client) UpdateLayout(ctx context.Context, id string, reqBody UpdateLayoutRequest) (*LayoutResponse, error) {
+	reqURL := fmt.Sprintf("/layouts/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "PATCH", reqURL, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var layout LayoutResponse
+	if err := json.NewDecoder(resp.Body).Decode(&layout); err != nil {
+		return nil, err
+	}
+
+	return &layout, nil
+}
+
+// DeleteLayout deletes a layout by its ID.
+func (c *Client) DeleteLayout(ctx context.Context, id string) error {
+	reqURL := fmt.Sprintf("/layouts/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "DELETE", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
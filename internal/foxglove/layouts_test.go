@@ -0,0 +1,24 @@
+package foxglove
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetLayoutIncludesData(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/layouts/lay_1" || r.URL.Query().Get("includeData") != "true" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"id":"lay_1","name":"Overview","permission":"ORG_READ","data":{"configById":{}}}`))
+	})
+
+	layout, err := client.GetLayout(context.Background(), "lay_1")
+	if err != nil {
+		t.Fatalf("Failed to get layout: %v", err)
+	}
+	if string(layout.Data) != `{"configById":{}}` {
+		t.Fatalf("Unexpected layout data %s", layout.Data)
+	}
+}
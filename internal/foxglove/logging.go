@@ -0,0 +1,160 @@
+package foxglove
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// httpLogSubsystem is the tflog subsystem used for HTTP traffic. Its level can
+// be set independently with TF_LOG_PROVIDER_FOXGLOVE_HTTP.
+const httpLogSubsystem = "foxglove_http"
+
+// maxLoggedBodySize limits the size of request and response bodies logged at TRACE level.
+const maxLoggedBodySize = 64 * 1024
+
+// sensitiveHeaders are never logged in clear text.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveBodyFields matches JSON fields carrying credentials in request and response bodies.
+var sensitiveBodyFields = regexp.MustCompile(`"(secretToken|token|secret|password)"\s*:\s*"[^"]*"`)
+
+// loggingTransport logs every HTTP exchange through tflog. Method, path,
+// status, latency and request ID are logged at DEBUG, headers and JSON bodies
+// at TRACE. Bodies are not read at all unless TRACE is enabled. Credentials
+// are masked before they reach the log.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(r.Context(), httpLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_FOXGLOVE_HTTP"))
+	maskedKeys := []string{}
+	for _, header := range sensitiveHeaders {
+		maskedKeys = append(maskedKeys, headerFieldKey("http_req_header_", header), headerFieldKey("http_res_header_", header))
+	}
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, httpLogSubsystem, maskedKeys...)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, httpLogSubsystem, sensitiveBodyFields)
+
+	fields := map[string]interface{}{
+		"http_method":   r.Method,
+		"http_url_host": r.URL.Host,
+		"http_url_path": r.URL.Path,
+	}
+
+	// bodies are only copied if they can end up in the log
+	trace := traceEnabled()
+	traceFields := headerFields("http_req_header_", r.Header)
+	if trace {
+		if body, ok := loggableRequestBody(r); ok {
+			traceFields["http_req_body"] = body
+		}
+	}
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Sending HTTP request", fields)
+	tflog.SubsystemTrace(ctx, httpLogSubsystem, "HTTP request details", fields, traceFields)
+
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	start := time.Now()
+	resp, err := next.RoundTrip(r)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "HTTP request failed", fields)
+		return resp, err
+	}
+
+	fields["http_status_code"] = resp.StatusCode
+	if requestID := resp.Header.Get("X-Request-Id"); requestID != "" {
+		fields["http_request_id"] = requestID
+	}
+
+	traceFields = headerFields("http_res_header_", resp.Header)
+	if trace {
+		if body, ok := loggableResponseBody(resp); ok {
+			traceFields["http_res_body"] = body
+		}
+	}
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Received HTTP response", fields)
+	tflog.SubsystemTrace(ctx, httpLogSubsystem, "HTTP response details", fields, traceFields)
+
+	return resp, nil
+}
+
+// traceEnabled reports whether HTTP traffic may be logged at TRACE level,
+// following how tflog picks the level of the subsystem from the environment.
+func traceEnabled() bool {
+	for _, name := range []string{"TF_LOG_PROVIDER_FOXGLOVE_HTTP", "TF_LOG_PROVIDER", "TF_LOG"} {
+		if level := os.Getenv(name); level != "" {
+			// JSON is Terraform's TRACE level with JSON output
+			return strings.EqualFold(level, "TRACE") || strings.EqualFold(level, "JSON")
+		}
+	}
+	return false
+}
+
+func headerFieldKey(prefix string, name string) string {
+	return prefix + strings.ReplaceAll(strings.ToLower(name), "-", "_")
+}
+
+func headerFields(prefix string, header http.Header) map[string]interface{} {
+	fields := map[string]interface{}{}
+	for name, values := range header {
+		fields[headerFieldKey(prefix, name)] = strings.Join(values, ", ")
+	}
+	return fields
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// loggableRequestBody returns a copy of small JSON request bodies without consuming them.
+func loggableRequestBody(r *http.Request) (string, bool) {
+	if r.GetBody == nil || !isJSON(r.Header.Get("Content-Type")) || r.ContentLength > maxLoggedBodySize {
+		return "", false
+	}
+	body, err := r.GetBody()
+	if err != nil {
+		return "", false
+	}
+	defer body.Close()
+	b, err := io.ReadAll(io.LimitReader(body, maxLoggedBodySize))
+	if err != nil {
+		return "", false
+	}
+	return string(b), true
+}
+
+// loggableResponseBody buffers small JSON response bodies so they can be logged
+// and still be read by the caller. Other bodies are left untouched so that
+// downloads keep streaming.
+func loggableResponseBody(resp *http.Response) (string, bool) {
+	if !isJSON(resp.Header.Get("Content-Type")) || resp.ContentLength > maxLoggedBodySize {
+		return "", false
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodySize+1))
+	// Whatever was read is put back in front of the remaining body.
+	resp.Body = readCloser{io.MultiReader(bytes.NewReader(b), resp.Body), resp.Body}
+	if err != nil || len(b) > maxLoggedBodySize {
+		return "", false
+	}
+	return string(b), true
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
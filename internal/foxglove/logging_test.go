@@ -0,0 +1,95 @@
+package foxglove
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransportMasksSecrets(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_FOXGLOVE_HTTP", "TRACE")
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_123")
+		w.Write([]byte(`{"id":"key_1","label":"robot","secretToken":"fox_sk_verysecret"}`))
+	})
+	client.APIKey = "fox_sk_providerkey"
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	resp, err := client.CreateAPIKey(ctx, CreateAPIKeyRequest{Label: "robot"})
+	if err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+	if resp.SecretToken != "fox_sk_verysecret" {
+		t.Fatalf("Expected the response body to be readable after logging, got %+v", resp)
+	}
+
+	logs := output.String()
+	for _, secret := range []string{"fox_sk_verysecret", "fox_sk_providerkey"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("Secret %q leaked into the logs:\n%s", secret, logs)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("Failed to decode logs: %v", err)
+	}
+
+	var found, bodyLogged bool
+	for _, entry := range entries {
+		if entry["@message"] == "HTTP response details" {
+			bodyLogged = entry["http_res_body"] != nil
+		}
+		if entry["@message"] == "Received HTTP response" {
+			found = true
+			if entry["http_status_code"] != float64(http.StatusOK) || entry["http_request_id"] != "req_123" || entry["http_url_path"] != "/api-keys" {
+				t.Errorf("Unexpected log fields: %v", entry)
+			}
+		}
+	}
+	if !found || !bodyLogged {
+		t.Fatalf("Expected the response and its body to be logged:\n%s", logs)
+	}
+}
+
+func TestLoggingTransportSkipsBodiesWithoutTrace(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_FOXGLOVE_HTTP", "DEBUG")
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"key_1","label":"robot"}`))
+	})
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	if _, err := client.CreateAPIKey(ctx, CreateAPIKeyRequest{Label: "robot"}); err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+	if logs := output.String(); strings.Contains(logs, "http_res_body") || strings.Contains(logs, "http_req_body") {
+		t.Errorf("Expected no bodies at DEBUG level:\n%s", logs)
+	}
+}
+
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestLoggingTransportError(t *testing.T) {
+	client := NewClient("test")
+	client.Client.Transport = &loggingTransport{next: failingTransport{}}
+	client.RetryPolicy.MaxRetries = 0
+
+	if _, err := client.ListAPIKeys(context.Background()); err == nil {
+		t.Fatal("Expected request to fail")
+	}
+}
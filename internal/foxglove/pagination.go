@@ -0,0 +1,67 @@
+package foxglove
+
+import (
+	"context"
+	"iter"
+)
+
+// DefaultPageSize is the number of items requested per page when paginating.
+const DefaultPageSize = 100
+
+// PageOptions controls how list endpoints are paginated.
+type PageOptions struct {
+	// PageSize is the number of items requested per page. Defaults to DefaultPageSize.
+	PageSize int
+	// MaxItems stops the iteration after that many items. Zero means no limit.
+	MaxItems int
+}
+
+// paginate returns an iterator over all items of a limit/offset list
+// endpoint. Pages are fetched lazily until a page comes back empty, the item
+// cap is reached or the consumer stops iterating. A short page does not end
+// the iteration, since the server may cap the page size. A fetch error is yielded
+// once and ends the iteration. id identifies items, so that an endpoint
+// ignoring the offset is not read forever.
+func paginate[T any](ctx context.Context, opts PageOptions, id func(T) string, fetch func(ctx context.Context, limit int, offset int) ([]T, error)) iter.Seq2[T, error] {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	return func(yield func(T, error) bool) {
+		count := 0
+		previousFirstID := ""
+		for offset := 0; ; {
+			page, err := fetch(ctx, pageSize, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			if len(page) > 0 {
+				if offset > 0 && id(page[0]) == previousFirstID {
+					return
+				}
+				previousFirstID = id(page[0])
+			}
+
+			for _, item := range page {
+				if opts.MaxItems > 0 && count >= opts.MaxItems {
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+				count++
+			}
+
+			// A page larger than requested means the endpoint ignores the
+			// paging parameters and already returned everything.
+			if len(page) == 0 || len(page) > pageSize {
+				return
+			}
+			offset += len(page)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// deviceListHandler serves count devices, honoring limit and offset unless
+// ignorePaging is set. Pages are capped at maxLimit items if it is positive.
+func deviceListHandler(t *testing.T, count int, maxLimit int, ignorePaging bool, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		limit, offset := count, 0
+		if !ignorePaging {
+			limit, _ = strconv.Atoi(r.URL.Query().Get("limit"))
+			offset, _ = strconv.Atoi(r.URL.Query().Get("offset"))
+		}
+		if maxLimit > 0 {
+			limit = min(limit, maxLimit)
+		}
+		if r.URL.Query().Get("query") != "robot" {
+			t.Errorf("Expected the filter to be sent with every page, got %s", r.URL.RawQuery)
+		}
+
+		devices := []ListDeviceResponse{}
+		for i := offset; i < offset+limit && i < count; i++ {
+			devices = append(devices, ListDeviceResponse{ID: fmt.Sprintf("dev_%d", i)})
+		}
+		json.NewEncoder(w).Encode(devices)
+	}
+}
+
+func TestAllDevices(t *testing.T) {
+	var requests int
+	client := newTestClient(t, deviceListHandler(t, 25, 0, false, &requests))
+
+	var ids []string
+	for device, err := range client.AllDevices(context.Background(), DeviceFilter{Query: "robot"}, PageOptions{PageSize: 10}) {
+		if err != nil {
+			t.Fatalf("Failed to list devices: %v", err)
+		}
+		ids = append(ids, device.ID)
+	}
+
+	if len(ids) != 25 || ids[0] != "dev_0" || ids[24] != "dev_24" {
+		t.Fatalf("Expected 25 devices in order, got %v", ids)
+	}
+	if requests != 4 {
+		t.Fatalf("Expected 4 page requests, got %d", requests)
+	}
+}
+
+func TestAllDevicesCappedPageSize(t *testing.T) {
+	var requests int
+	client := newTestClient(t, deviceListHandler(t, 25, 5, false, &requests))
+
+	var ids []string
+	for device, err := range client.AllDevices(context.Background(), DeviceFilter{Query: "robot"}, PageOptions{PageSize: 10}) {
+		if err != nil {
+			t.Fatalf("Failed to list devices: %v", err)
+		}
+		ids = append(ids, device.ID)
+	}
+
+	if len(ids) != 25 || ids[5] != "dev_5" || ids[24] != "dev_24" {
+		t.Fatalf("Expected 25 devices in order, got %v", ids)
+	}
+	if requests != 6 {
+		t.Fatalf("Expected 6 page requests, got %d", requests)
+	}
+}
+
+func TestAllDevicesMaxItems(t *testing.T) {
+	var requests int
+	client := newTestClient(t, deviceListHandler(t, 25, 0, false, &requests))
+
+	count := 0
+	for _, err := range client.AllDevices(context.Background(), DeviceFilter{Query: "robot"}, PageOptions{PageSize: 10, MaxItems: 15}) {
+		if err != nil {
+			t.Fatalf("Failed to list devices: %v", err)
+		}
+		count++
+	}
+
+	if count != 15 || requests != 2 {
+		t.Fatalf("Expected 15 devices from 2 pages, got %d devices from %d pages", count, requests)
+	}
+}
+
+func TestAllDevicesIgnoredPaging(t *testing.T) {
+	var requests int
+	client := newTestClient(t, deviceListHandler(t, 25, 0, true, &requests))
+
+	count := 0
+	for _, err := range client.AllDevices(context.Background(), DeviceFilter{Query: "robot"}, PageOptions{PageSize: 10}) {
+		if err != nil {
+			t.Fatalf("Failed to list devices: %v", err)
+		}
+		count++
+	}
+
+	if count != 25 || requests != 1 {
+		t.Fatalf("Expected 25 devices from a single request, got %d devices from %d requests", count, requests)
+	}
+}
+
+func TestAllDevicesIgnoredOffset(t *testing.T) {
+	var requests int
+	client := newTestClient(t, deviceListHandler(t, 10, 0, true, &requests))
+
+	count := 0
+	for _, err := range client.AllDevices(context.Background(), DeviceFilter{Query: "robot"}, PageOptions{PageSize: 10}) {
+		if err != nil {
+			t.Fatalf("Failed to list devices: %v", err)
+		}
+		count++
+	}
+
+	if count != 10 || requests != 2 {
+		t.Fatalf("Expected 10 devices from 2 requests, got %d devices from %d requests", count, requests)
+	}
+}
+
+func TestAllDevicesError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	for _, err := range client.AllDevices(context.Background(), DeviceFilter{}, PageOptions{}) {
+		if !IsUnauthorized(err) {
+			t.Fatalf("Expected an unauthorized error, got: %v", err)
+		}
+		return
+	}
+	t.Fatal("Expected the error to be yielded")
+}
@@ -0,0 +1,229 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// Import states of a recording.
+const (
+	ImportStatusNone     = "none"
+	ImportStatusPending  = "pending"
+	ImportStatusComplete = "complete"
+	ImportStatusError    = "error"
+	ImportStatusFailed   = "failed"
+)
+
+// RecordingDevice references the device a recording belongs to.
+type RecordingDevice struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// RecordingSite references the site a recording is stored on.
+type RecordingSite struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// RecordingResponse represents a recording.
+type RecordingResponse struct {
+	ID           string           `json:"id"`
+	Path         string           `json:"path"`
+	Key          string           `json:"key,omitempty"`
+	Size         int64            `json:"size"`
+	MessageCount int64            `json:"messageCount"`
+	CreatedAt    string           `json:"createdAt"`
+	ImportedAt   string           `json:"importedAt"`
+	Start        string           `json:"start"`
+	End          string           `json:"end"`
+	ImportStatus string           `json:"importStatus"`
+	Site         *RecordingSite   `json:"site"`
+	EdgeSite     *RecordingSite   `json:"edgeSite"`
+	Device       *RecordingDevice `json:"device"`
+}
+
+// RecordingFilter narrows down the recordings returned by ListRecordings and
+// AllRecordings. Zero values are not sent.
+type RecordingFilter struct {
+	DeviceID     string
+	DeviceName   string
+	Start        time.Time
+	End          time.Time
+	Path         string
+	ImportStatus string
+	SortBy       string
+	SortOrder    string
+}
+
+func (f RecordingFilter) values() url.Values {
+	params := url.Values{}
+	if f.DeviceID != "" {
+		params.Add("device.id", f.DeviceID)
+	}
+	if f.DeviceName != "" {
+		params.Add("device.name", f.DeviceName)
+	}
+	if !f.Start.IsZero() {
+		params.Add("start", f.Start.UTC().Format(time.RFC3339Nano))
+	}
+	if !f.End.IsZero() {
+		params.Add("end", f.End.UTC().Format(time.RFC3339Nano))
+	}
+	if f.Path != "" {
+		params.Add("path", f.Path)
+	}
+	if f.ImportStatus != "" {
+		params.Add("importStatus", f.ImportStatus)
+	}
+	if f.SortBy != "" {
+		params.Add("sortBy", f.SortBy)
+	}
+	if f.SortOrder != "" {
+		params.Add("sortOrder", f.SortOrder)
+	}
+	return params
+}
+
+// ListRecordings fetches a page of recordings matching filter.
+func (c *Client) ListRecordings(ctx context.Context, filter RecordingFilter, limit int, offset int) ([]RecordingResponse, error) {
+	params := filter.values()
+	if limit > 0 {
+		params.Add("limit", fmt.Sprintf("%d", limit))
+	}
+	if offset > 0 {
+		params.Add("offset", fmt.Sprintf("%d", offset))
+	}
+
+	resp, err := c.doRequest(ctx, "GET", "/recordings?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var recordings []RecordingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&recordings); err != nil {
+		return nil, err
+	}
+
+	return recordings, nil
+}
+
+// AllRecordings returns an iterator over every recording matching filter,
+// following limit/offset pagination until all pages have been read.
+func (c *Client) AllRecordings(ctx context.Context, filter RecordingFilter, opts PageOptions) iter.Seq2[RecordingResponse, error] {
+	return paginate(ctx, opts, func(item RecordingResponse) string { return item.ID }, func(ctx context.Context, limit int, offset int) ([]RecordingResponse, error) {
+		return c.ListRecordings(ctx, filter, limit, offset)
+	})
+}
+
+// GetRecording retrieves the details of a specific recording by its ID.
+func (c *Client) GetRecording(ctx context.Context, id string) (*RecordingResponse, error) {
+	reqURL := fmt.Sprintf("/recordings/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var recording RecordingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&recording); err != nil {
+		return nil, err
+	}
+
+	return &recording, nil
+}
+
+// DeleteRecording deletes a recording by its ID.
+func (c *Client) DeleteRecording(ctx context.Context, id string) error {
+	reqURL := fmt.Sprintf("/recordings/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "DELETE", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ImportResponse represents the import of an uploaded file.
+type ImportResponse struct {
+	ImportID        string           `json:"importId"`
+	DeviceID        string           `json:"deviceId"`
+	Device          *RecordingDevice `json:"device"`
+	Filename        string           `json:"filename"`
+	ImportTime      string           `json:"importTime"`
+	Start           string           `json:"start"`
+	End             string           `json:"end"`
+	InputType       string           `json:"inputType"`
+	OutputType      string           `json:"outputType"`
+	InputSize       int64            `json:"inputSize"`
+	TotalOutputSize int64            `json:"totalOutputSize"`
+}
+
+// ImportFilter narrows down the imports returned by ListImports and
+// AllImports. Zero values are not sent.
+type ImportFilter struct {
+	DeviceID   string
+	DeviceName string
+	Start      time.Time
+	End        time.Time
+	SortBy     string
+	SortOrder  string
+}
+
+// ListImports fetches a page of imports matching filter.
+func (c *Client) ListImports(ctx context.Context, filter ImportFilter, limit int, offset int) ([]ImportResponse, error) {
+	params := url.Values{}
+	if filter.DeviceID != "" {
+		params.Add("deviceId", filter.DeviceID)
+	}
+	if filter.DeviceName != "" {
+		params.Add("device.name", filter.DeviceName)
+	}
+	if !filter.Start.IsZero() {
+		params.Add("start", filter.Start.UTC().Format(time.RFC3339Nano))
+	}
+	if !filter.End.IsZero() {
+		params.Add("end", filter.End.UTC().Format(time.RFC3339Nano))
+	}
+	if filter.SortBy != "" {
+		params.Add("sortBy", filter.SortBy)
+	}
+	if filter.SortOrder != "" {
+		params.Add("sortOrder", filter.SortOrder)
+	}
+	if limit > 0 {
+		params.Add("limit", fmt.Sprintf("%d", limit))
+	}
+	if offset > 0 {
+		params.Add("offset", fmt.Sprintf("%d", offset))
+	}
+
+	resp, err := c.doRequest(ctx, "GET", "/data/imports?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var imports []ImportResponse
+	if err := json.NewDecoder(resp.Body).Decode(&imports); err != nil {
+		return nil, err
+	}
+
+	return imports, nil
+}
+
+// AllImports returns an iterator over every import matching filter,
+// following limit/offset pagination until all pages have been read.
+func (c *Client) AllImports(ctx context.Context, filter ImportFilter, opts PageOptions) iter.Seq2[ImportResponse, error] {
+	return paginate(ctx, opts, func(item ImportResponse) string { return item.ImportID }, func(ctx context.Context, limit int, offset int) ([]ImportResponse, error) {
+		return c.ListImports(ctx, filter, limit, offset)
+	})
+}
@@ -0,0 +1,64 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestListRecordingsFilter(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/recordings" {
+			t.Errorf("Unexpected request path %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		expected := map[string]string{
+			"device.id":    "dev_1",
+			"start":        "2024-01-01T00:00:00Z",
+			"end":          "2024-01-02T00:00:00Z",
+			"path":         "/logs/run.mcap",
+			"importStatus": ImportStatusComplete,
+			"limit":        "10",
+		}
+		for key, value := range expected {
+			if query.Get(key) != value {
+				t.Errorf("Expected %s=%s, got %q", key, value, query.Get(key))
+			}
+		}
+		if query.Has("device.name") || query.Has("offset") {
+			t.Errorf("Expected unset filters to be omitted, got %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode([]RecordingResponse{{ID: "rec_1", Device: &RecordingDevice{ID: "dev_1", Name: "robot"}}})
+	})
+
+	recordings, err := client.ListRecordings(context.Background(), RecordingFilter{
+		DeviceID:     "dev_1",
+		Start:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:          time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Path:         "/logs/run.mcap",
+		ImportStatus: ImportStatusComplete,
+	}, 10, 0)
+	if err != nil {
+		t.Fatalf("Failed to list recordings: %v", err)
+	}
+	if len(recordings) != 1 || recordings[0].Device.Name != "robot" {
+		t.Fatalf("Unexpected recordings %+v", recordings)
+	}
+}
+
+func TestGetRecordingNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/recordings/rec_missing" {
+			t.Errorf("Unexpected request path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Recording not found"}`))
+	})
+
+	_, err := client.GetRecording(context.Background(), "rec_missing")
+	if !IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package foxglove
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// request describes an HTTP request sent by the client.
+type request struct {
+	method string
+	// path is appended to the BaseURL of the client.
+	path string
+	// url is an absolute URL outside of the api, such as a signed upload or
+	// download link. It replaces path, and the request carries no api key.
+	url         string
+	body        *body
+	contentType string
+	// stream marks transfers of files, which are not limited by the
+	// Timeout of the client.
+	stream bool
+}
+
+// body is the body of a request. It is streamed rather than buffered, and
+// opened again for every attempt, so that retries resend it in full.
+type body struct {
+	// open returns the content of the body.
+	open func() (io.Reader, error)
+	// size is the length of the body.
+	size int64
+}
+
+// bytesBody returns a body sending b.
+func bytesBody(b []byte) *body {
+	return &body{
+		open: func() (io.Reader, error) { return bytes.NewReader(b), nil },
+		size: int64(len(b)),
+	}
+}
+
+// seekerBody returns a body sending r from its current offset. Retries seek
+// back to that offset.
+func seekerBody(r io.ReadSeeker) (*body, error) {
+	start, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return &body{
+		open: func() (io.Reader, error) {
+			if _, err := r.Seek(start, io.SeekStart); err != nil {
+				return nil, err
+			}
+			// hide Close, or the transport closes files after the first attempt
+			return struct{ io.Reader }{r}, nil
+		},
+		size: end - start,
+	}, nil
+}
+
+// do sends r, retrying transient failures according to the client's
+// RetryPolicy. The body of the returned
+// response is not read, so that large downloads can be streamed; the caller
+// must close it.
+func (c *Client) do(ctx context.Context, r request) (*http.Response, error) {
+	url, logURL := r.url, r.url
+	if url == "" {
+		url = c.BaseURL + r.path
+		logURL = url
+	} else {
+		// signed links carry their credentials in the query
+		logURL, _, _ = strings.Cut(url, "?")
+	}
+
+	for retry := 0; ; retry++ {
+		var content io.Reader
+		if r.body != nil {
+			var err error
+			if content, err = r.body.open(); err != nil {
+				return nil, err
+			}
+		}
+
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+		if c.Timeout > 0 && !r.stream {
+			attemptCtx, cancel = context.WithTimeout(ctx, c.Timeout)
+		}
+
+		req, err := http.NewRequestWithContext(attemptCtx, r.method, url, content)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		if r.body != nil {
+			req.ContentLength = r.body.size
+			if r.body.size == 0 {
+				req.Body = http.NoBody
+			}
+		}
+
+		if r.url == "" {
+			c.authorize(req)
+		}
+
+		if c.UserAgent != "" {
+			req.Header.Set("User-Agent", c.UserAgent)
+		}
+
+		if r.contentType != "" {
+			req.Header.Set("Content-Type", r.contentType)
+		}
+
+		resp, err := c.Client.Do(req)
+		if err == nil && (resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated) {
+			// the timeout keeps running until the caller has read the response
+			resp.Body = cancelBody{resp.Body, cancel}
+			return resp, nil
+		}
+
+		if retry < c.RetryPolicy.MaxRetries && c.RetryPolicy.shouldRetry(ctx, r.method, resp, err) {
+			if wait, ok := c.RetryPolicy.backoff(retry+1, resp); ok {
+				if resp != nil {
+					io.Copy(io.Discard, resp.Body)
+					resp.Body.Close()
+				}
+				cancel()
+				tflog.Debug(ctx, "retrying foxglove api request", map[string]interface{}{
+					"method": r.method,
+					"url":    logURL,
+					"retry":  retry + 1,
+					"wait":   wait.String(),
+				})
+				if err := sleep(ctx, wait); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		defer cancel()
+		defer resp.Body.Close()
+		apiErr := newAPIError(resp)
+		apiErr.URL = logURL
+		return nil, apiErr
+	}
+}
+
+// cancelBody is a response body which releases the timeout of its request
+// when it is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,91 @@
+package foxglove
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSeekerBodyRetriesFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "scene.mcap")
+	if err := os.WriteFile(name, []byte("mcap content"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	body, err := seekerBody(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attempts := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		content, _ := io.ReadAll(r.Body)
+		if r.ContentLength != 12 || string(content) != "mcap content" {
+			t.Errorf("Unexpected body %q (%d bytes)", content, r.ContentLength)
+		}
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	resp, err := client.do(context.Background(), request{method: "PUT", path: "/upload", body: body})
+	if err != nil {
+		t.Fatalf("Failed to send file: %v", err)
+	}
+	resp.Body.Close()
+	if attempts != 2 {
+		t.Fatalf("Expected the file to be sent again on retry, got %d attempts", attempts)
+	}
+}
+
+func TestSignedURLIsNotAuthorized(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("Expected no api key for a signed url, got %q", auth)
+		}
+		if r.URL.Query().Get("signature") != "abc" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		w.Write([]byte("streamed"))
+	})
+
+	resp, err := client.do(context.Background(), request{method: "GET", url: client.BaseURL + "/download?signature=abc"})
+	if err != nil {
+		t.Fatalf("Failed to download: %v", err)
+	}
+	defer resp.Body.Close()
+	content, _ := io.ReadAll(resp.Body)
+	if string(content) != "streamed" {
+		t.Fatalf("Unexpected response %q", content)
+	}
+}
+
+func TestTimeoutSkipsStreams(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("[]"))
+	})
+	client.Timeout = 10 * time.Millisecond
+	client.RetryPolicy.MaxRetries = 0
+
+	if _, err := client.ListAPIKeys(context.Background()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the api request to time out, got %v", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := client.Download(context.Background(), client.BaseURL+"/download?signature=abc", &buf); err != nil {
+		t.Fatalf("Expected the download not to time out, got %v", err)
+	}
+}
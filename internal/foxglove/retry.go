@@ -0,0 +1,126 @@
+package foxglove
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how requests that fail with a transient error are retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt. Zero disables retries.
+	MaxRetries int
+	// MinWait is the base delay of the exponential backoff.
+	MinWait time.Duration
+	// MaxWait caps the delay between two attempts. A Retry-After header asking
+	// for a longer delay makes the request fail instead of waiting. Zero means
+	// no cap, so any Retry-After is waited for.
+	MaxWait time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used by NewClient.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 4,
+		MinWait:    500 * time.Millisecond,
+		MaxWait:    30 * time.Second,
+	}
+}
+
+type retrySafeKey struct{}
+
+// WithRetrySafe marks requests sent with the returned context as safe to retry,
+// even if their HTTP method is not idempotent.
+func WithRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+func isRetrySafe(ctx context.Context, method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	safe, _ := ctx.Value(retrySafeKey{}).(bool)
+	return safe
+}
+
+// shouldRetry reports whether the outcome of an attempt is worth another try.
+// A 429 means the request was rejected before being processed, so it is
+// retried for every method. Other failures are only retried when repeating the
+// request cannot cause duplicate side effects.
+func (p RetryPolicy) shouldRetry(ctx context.Context, method string, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return isRetrySafe(ctx, method)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isRetrySafe(ctx, method)
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry (starting at 1). The second
+// return value is false if the server asked for a delay longer than MaxWait.
+func (p RetryPolicy) backoff(retry int, resp *http.Response) (time.Duration, bool) {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			if p.MaxWait > 0 && wait > p.MaxWait {
+				return 0, false
+			}
+			return wait, true
+		}
+	}
+
+	wait := p.MinWait
+	for i := 1; i < retry && (p.MaxWait <= 0 || wait < p.MaxWait); i++ {
+		wait *= 2
+	}
+	if p.MaxWait > 0 && wait > p.MaxWait {
+		wait = p.MaxWait
+	}
+	if wait <= 0 {
+		return 0, true
+	}
+	// Full jitter keeps a fleet of concurrent requests from retrying in lockstep.
+	return time.Duration(rand.Int63n(int64(wait))) + 1, true
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,141 @@
+package foxglove
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient("test")
+	client.BaseURL = server.URL
+	client.RetryPolicy = RetryPolicy{
+		MaxRetries: 3,
+		MinWait:    time.Millisecond,
+		MaxWait:    10 * time.Millisecond,
+	}
+	return client
+}
+
+func TestRetryTransientErrors(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`[]`))
+	})
+
+	if _, err := client.ListAPIKeys(context.Background()); err != nil {
+		t.Fatalf("Expected request to succeed after retries, got: %v", err)
+	}
+	if attempts.Load() != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", attempts.Load())
+	}
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	if _, err := client.ListAPIKeys(context.Background()); err == nil {
+		t.Fatal("Expected request to fail")
+	}
+	if attempts.Load() != 4 {
+		t.Fatalf("Expected 4 attempts, got %d", attempts.Load())
+	}
+}
+
+func TestRetryOnlyIdempotentRequests(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	if _, err := client.CreateDevice(context.Background(), CreateDeviceRequest{Name: "foo"}); err == nil {
+		t.Fatal("Expected request to fail")
+	}
+	if attempts.Load() != 1 {
+		t.Fatalf("Expected a POST to be sent once, got %d attempts", attempts.Load())
+	}
+
+	attempts.Store(0)
+	if _, err := client.CreateDevice(WithRetrySafe(context.Background()), CreateDeviceRequest{Name: "foo"}); err == nil {
+		t.Fatal("Expected request to fail")
+	}
+	if attempts.Load() != 4 {
+		t.Fatalf("Expected a POST marked as retry safe to be retried, got %d attempts", attempts.Load())
+	}
+}
+
+func TestRetryTooManyRequests(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id":"dev_1","name":"foo"}`))
+	})
+
+	if _, err := client.CreateDevice(context.Background(), CreateDeviceRequest{Name: "foo"}); err != nil {
+		t.Fatalf("Expected a rate limited POST to be retried, got: %v", err)
+	}
+	if attempts.Load() != 2 {
+		t.Fatalf("Expected 2 attempts, got %d", attempts.Load())
+	}
+}
+
+func TestRetryAfterLongerThanMaxWait(t *testing.T) {
+	var attempts atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	if _, err := client.ListAPIKeys(context.Background()); err == nil {
+		t.Fatal("Expected request to fail")
+	}
+	if attempts.Load() != 1 {
+		t.Fatalf("Expected no retry when Retry-After exceeds the maximum wait, got %d attempts", attempts.Load())
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{"-1", 0, false},
+		{"Mon, 01 Jan 2024 12:00:10 GMT", 10 * time.Second, true},
+		{"Mon, 01 Jan 2024 11:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+
+	for _, test := range tests {
+		got, ok := parseRetryAfter(test.value, now)
+		if got != test.want || ok != test.ok {
+			t.Errorf("parseRetryAfter(%q) = %v, %v; expected %v, %v", test.value, got, ok, test.want, test.ok)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Types of a site.
+const (
+	SiteTypeSelfHosted = "self-hosted"
+	SiteTypeEdge       = "edge"
+)
+
+// SiteResponse represents a primary or edge site.
+type SiteResponse struct {
+	ID                      string `json:"id"`
+	Name                    string `json:"name"`
+	Type                    string `json:"type"`
+	URL                     string `json:"url"`
+	RetainRecordingsSeconds *int64 `json:"retainRecordingsSeconds"`
+}
+
+// ListSites fetches the list of sites.
+func (c *Client) ListSites(ctx context.Context) ([]SiteResponse, error) {
+	resp, err := c.doRequest(ctx, "GET", "/sites", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var sites []SiteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&sites); err != nil {
+		return nil, err
+	}
+
+	return sites, nil
+}
+
+// CreateSiteRequest represents the payload to create a new site.
+type CreateSiteRequest struct {
+	Name                    string `json:"name"`
+	Type                    string `json:"type"`
+	RetainRecordingsSeconds *int64 `json:"retainRecordingsSeconds,omitempty"`
+}
+
+// CreateSite creates a new site.
+func (c *Client) CreateSite(ctx context.Context, reqBody CreateSiteRequest) (*SiteResponse, error) {
+	resp, err := c.doRequest(ctx, "POST", "/sites", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var site SiteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&site); err != nil {
+		return nil, err
+	}
+
+	return &site, nil
+}
+
+// GetSite retrieves the details of a specific site by its ID.
+func (c *Client) GetSite(ctx context.Context, id string) (*SiteResponse, error) {
+	reqURL := fmt.Sprintf("/sites/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var site SiteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&site); err != nil {
+		return nil, err
+	}
+
+	return &site, nil
+}
+
+// UpdateSiteRequest represents the payload to update a site.
+type UpdateSiteRequest struct {
+	Name                    string `json:"name,omitempty"`
+	RetainRecordingsSeconds *int64 `json:"retainRecordingsSeconds,omitempty"`
+}
+
+// UpdateSite updates a specific site by its ID.
+func (c *Client) UpdateSite(ctx context.Context, id string, reqBody UpdateSiteRequest) (*SiteResponse, error) {
+	reqURL := fmt.Sprintf("/sites/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "PATCH", reqURL, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var site SiteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&site); err != nil {
+		return nil, err
+	}
+
+	return &site, nil
+}
+
+// DeleteSite deletes a site by its ID.
+func (c *Client) DeleteSite(ctx context.Context, id string) error {
+	reqURL := fmt.Sprintf("/sites/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "DELETE", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// SiteTokenResponse represents a token a site uses to authenticate.
+type SiteTokenResponse struct {
+	ID     string `json:"id"`
+	SiteID string `json:"siteId"`
+}
+
+// ListSiteTokens fetches the tokens of a site, or of all sites if siteID is empty.
+func (c *Client) ListSiteTokens(ctx context.Context, siteID string) ([]SiteTokenResponse, error) {
+	reqURL := "/site-tokens"
+	if siteID != "" {
+		reqURL += "?" + url.Values{"siteId": {siteID}}.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var tokens []SiteTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// GetSiteToken retrieves a specific site token by its ID. The API has no
+// endpoint for a single token, so the token is looked up in the list of all tokens.
+func (c *Client) GetSiteToken(ctx context.Context, id string) (*SiteTokenResponse, error) {
+	tokens, err := c.ListSiteTokens(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range tokens {
+		if token.ID == id {
+			return &token, nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("site token %s not found", id),
+		Method:     "GET",
+		URL:        c.BaseURL + "/site-tokens",
+	}
+}
+
+// CreateSiteTokenRequest represents the payload to create a new site token.
+type CreateSiteTokenRequest struct {
+	SiteID string `json:"siteId"`
+}
+
+// CreateSiteTokenResponse represents the response returned after creating a
+// site token. The token is only returned once.
+type CreateSiteTokenResponse struct {
+	ID     string `json:"id"`
+	SiteID string `json:"siteId"`
+	Token  string `json:"token"`
+}
+
+// CreateSiteToken creates a new token for a site.
+func (c *Client) CreateSiteToken(ctx context.Context, reqBody CreateSiteTokenRequest) (*CreateSiteTokenResponse, error) {
+	resp, err := c.doRequest(ctx, "POST", "/site-tokens", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var token CreateSiteTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// DeleteSiteToken deletes a site token by its ID.
+func (c *Client) DeleteSiteToken(ctx context.Context, id string) error {
+	reqURL := fmt.Sprintf("/site-tokens/%s", url.PathEscape(id))
+
+	resp, err := c.doRequest(ctx, "DELETE", reqURL, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package foxglove
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetSiteToken(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/site-tokens" || r.URL.RawQuery != "" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`[{"id":"tok_1","siteId":"site_1"},{"id":"tok_2","siteId":"site_2"}]`))
+	})
+
+	token, err := client.GetSiteToken(context.Background(), "tok_2")
+	if err != nil {
+		t.Fatalf("Failed to get site token: %v", err)
+	}
+	if token.SiteID != "site_2" {
+		t.Fatalf("Unexpected site token %+v", token)
+	}
+
+	if _, err := client.GetSiteToken(context.Background(), "tok_missing"); !IsNotFound(err) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// Output formats of streamed data.
+const (
+	StreamFormatMCAP = "mcap"
+	StreamFormatBag  = "bag1"
+	StreamFormatJSON = "json"
+)
+
+// StreamRequest selects the data to stream, either of a recording or of a
+// device within a time range. Zero values are not sent.
+type StreamRequest struct {
+	DeviceID    string
+	DeviceName  string
+	RecordingID string
+	Start       time.Time
+	End         time.Time
+	// Topics limits the data to the given topics, or all topics if empty.
+	Topics       []string
+	OutputFormat string
+	// IncludeAttachments adds the attachments of MCAP recordings.
+	IncludeAttachments bool
+}
+
+type streamRequest struct {
+	DeviceID           string   `json:"deviceId,omitempty"`
+	DeviceName         string   `json:"deviceName,omitempty"`
+	RecordingID        string   `json:"recordingId,omitempty"`
+	Start              string   `json:"start,omitempty"`
+	End                string   `json:"end,omitempty"`
+	Topics             []string `json:"topics,omitempty"`
+	OutputFormat       string   `json:"outputFormat,omitempty"`
+	IncludeAttachments bool     `json:"includeAttachments,omitempty"`
+}
+
+// StreamResponse holds the signed link to download streamed data from.
+type StreamResponse struct {
+	Link string `json:"link"`
+}
+
+// StreamData requests a signed link to download the data selected by req.
+func (c *Client) StreamData(ctx context.Context, req StreamRequest) (*StreamResponse, error) {
+	reqBody := streamRequest{
+		DeviceID:           req.DeviceID,
+		DeviceName:         req.DeviceName,
+		RecordingID:        req.RecordingID,
+		Topics:             req.Topics,
+		OutputFormat:       req.OutputFormat,
+		IncludeAttachments: req.IncludeAttachments,
+	}
+	if !req.Start.IsZero() {
+		reqBody.Start = req.Start.UTC().Format(time.RFC3339Nano)
+	}
+	if !req.End.IsZero() {
+		reqBody.End = req.End.UTC().Format(time.RFC3339Nano)
+	}
+
+	// requesting a link has no side effects
+	resp, err := c.doRequest(WithRetrySafe(ctx), "POST", "/data/stream", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var stream StreamResponse
+	if err := json.NewDecoder(resp.Body).Decode(&stream); err != nil {
+		return nil, err
+	}
+
+	return &stream, nil
+}
+
+// Download streams the content of a signed link, such as the link returned
+// by StreamData, to w without buffering it. It returns the number of bytes
+// written. Failures after the download started are not retried.
+func (c *Client) Download(ctx context.Context, link string, w io.Writer) (int64, error) {
+	resp, err := c.do(ctx, request{method: "GET", url: link, stream: true})
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	return io.Copy(w, resp.Body)
+}
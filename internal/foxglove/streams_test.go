@@ -0,0 +1,55 @@
+package foxglove
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestStreamDataAndDownload(t *testing.T) {
+	var client *Client
+	client = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/data/stream":
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req["deviceId"] != "dev_1" || req["start"] != "2024-01-01T00:00:00Z" || req["outputFormat"] != StreamFormatMCAP {
+				t.Errorf("Unexpected stream request %v", req)
+			}
+			if _, ok := req["recordingId"]; ok {
+				t.Errorf("Expected no recordingId, got %v", req)
+			}
+			json.NewEncoder(w).Encode(StreamResponse{Link: client.BaseURL + "/download?signature=abc"})
+		case "/download":
+			if auth := r.Header.Get("Authorization"); auth != "" {
+				t.Errorf("Expected no api key for the download link, got %q", auth)
+			}
+			w.Write([]byte("mcap data"))
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	})
+
+	stream, err := client.StreamData(context.Background(), StreamRequest{
+		DeviceID:     "dev_1",
+		Start:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:          time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+		Topics:       []string{"/camera"},
+		OutputFormat: StreamFormatMCAP,
+	})
+	if err != nil {
+		t.Fatalf("Failed to request stream: %v", err)
+	}
+
+	var buf bytes.Buffer
+	n, err := client.Download(context.Background(), stream.Link, &buf)
+	if err != nil {
+		t.Fatalf("Failed to download: %v", err)
+	}
+	if n != 9 || buf.String() != "mcap data" {
+		t.Fatalf("Unexpected download %q (%d bytes)", buf.String(), n)
+	}
+}
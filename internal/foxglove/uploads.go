@@ -0,0 +1,137 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// DefaultImportPollInterval is how often ImportRecording checks the import
+// status of an uploaded recording unless another interval is requested.
+const DefaultImportPollInterval = 5 * time.Second
+
+// CreateUploadRequest represents the payload to request an upload link. Either
+// DeviceID or DeviceName identifies the device the recording belongs to.
+type CreateUploadRequest struct {
+	Filename   string `json:"filename"`
+	DeviceID   string `json:"deviceId,omitempty"`
+	DeviceName string `json:"deviceName,omitempty"`
+	// Key is an optional unique key of the upload, which lets Foxglove
+	// recognize repeated uploads of the same file.
+	Key string `json:"key,omitempty"`
+}
+
+// UploadResponse holds the signed link to upload a file to.
+type UploadResponse struct {
+	Link string `json:"link"`
+}
+
+// CreateUpload requests a signed link to upload a recording to.
+func (c *Client) CreateUpload(ctx context.Context, reqBody CreateUploadRequest) (*UploadResponse, error) {
+	resp, err := c.doRequest(ctx, "POST", "/data/upload", reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var upload UploadResponse
+	if err := json.NewDecoder(resp.Body).Decode(&upload); err != nil {
+		return nil, err
+	}
+
+	return &upload, nil
+}
+
+// UploadFile streams file to a signed link returned by CreateUpload. The file
+// is read from its current offset, and read again if the upload is retried.
+func (c *Client) UploadFile(ctx context.Context, link string, file io.ReadSeeker) error {
+	body, err := seekerBody(file)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(ctx, request{
+		method:      "PUT",
+		url:         link,
+		body:        body,
+		contentType: "application/octet-stream",
+		stream:      true,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ImportError is returned when Foxglove fails to import an uploaded recording.
+type ImportError struct {
+	Recording *RecordingResponse
+}
+
+func (e *ImportError) Error() string {
+	return fmt.Sprintf("import of recording %s (%s) ended with status %q", e.Recording.ID, e.Recording.Path, e.Recording.ImportStatus)
+}
+
+// ImportRecordingRequest describes a recording to upload and import.
+type ImportRecordingRequest struct {
+	CreateUploadRequest
+	// PollInterval is how often the import status is checked, or
+	// DefaultImportPollInterval if zero.
+	PollInterval time.Duration
+}
+
+// ImportRecording uploads file and waits until Foxglove has imported it. If
+// req.Key is set and a recording with that key exists already, that recording
+// is returned once imported. It returns an *ImportError if the import fails.
+// The wait is bounded by ctx only.
+func (c *Client) ImportRecording(ctx context.Context, req ImportRecordingRequest, file io.ReadSeeker) (*RecordingResponse, error) {
+	interval := req.PollInterval
+	if interval == 0 {
+		interval = DefaultImportPollInterval
+	}
+
+	// uploads of the same filename can only be told apart by their recording ID
+	filter := RecordingFilter{DeviceID: req.DeviceID, DeviceName: req.DeviceName, Path: req.Filename}
+	previous := map[string]bool{}
+	for recording, err := range c.AllRecordings(ctx, filter, PageOptions{}) {
+		if err != nil {
+			return nil, err
+		}
+		previous[recording.ID] = true
+	}
+
+	upload, err := c.CreateUpload(ctx, req.CreateUploadRequest)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.UploadFile(ctx, upload.Link, file); err != nil {
+		return nil, err
+	}
+
+	for {
+		for recording, err := range c.AllRecordings(ctx, filter, PageOptions{}) {
+			if err != nil {
+				return nil, err
+			}
+			// Foxglove does not import an upload again if a recording with
+			// the same key exists already, so that recording is the result
+			if previous[recording.ID] && (req.Key == "" || recording.Key != req.Key) {
+				continue
+			}
+			switch recording.ImportStatus {
+			case ImportStatusComplete:
+				return &recording, nil
+			case ImportStatusError, ImportStatusFailed:
+				return nil, &ImportError{Recording: &recording}
+			}
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return nil, fmt.Errorf("waiting for the import of %s: %w", req.Filename, err)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package foxglove
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newImportServer serves an upload link and the recordings of a device, whose
+// newly uploaded recording goes through statuses on every listing. The device
+// has an older recording uploaded with oldKey.
+func newImportServer(t *testing.T, oldKey string, statuses ...string) *Client {
+	t.Helper()
+
+	var client *Client
+	uploaded := false
+	listings := 0
+	client = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/data/upload":
+			var req CreateUploadRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Filename != "scene.mcap" || req.DeviceID != "dev_1" || req.Key != "seed" {
+				t.Errorf("Unexpected upload request %+v", req)
+			}
+			json.NewEncoder(w).Encode(UploadResponse{Link: client.BaseURL + "/signed?signature=abc"})
+		case r.Method == "PUT" && r.URL.Path == "/signed":
+			if auth := r.Header.Get("Authorization"); auth != "" {
+				t.Errorf("Expected no api key for the upload link, got %q", auth)
+			}
+			content, _ := io.ReadAll(r.Body)
+			if string(content) != "mcap content" {
+				t.Errorf("Unexpected upload %q", content)
+			}
+			uploaded = true
+		case r.Method == "GET" && r.URL.Path == "/recordings":
+			if r.URL.Query().Get("path") != "scene.mcap" {
+				t.Errorf("Unexpected recordings query %s", r.URL.RawQuery)
+			}
+			if r.URL.Query().Has("offset") {
+				// all recordings fit on the first page
+				json.NewEncoder(w).Encode([]RecordingResponse{})
+				return
+			}
+			recordings := []RecordingResponse{{ID: "rec_old", Path: "scene.mcap", Key: oldKey, ImportStatus: ImportStatusComplete}}
+			if uploaded && listings < len(statuses) {
+				recordings = append(recordings, RecordingResponse{ID: "rec_new", Path: "scene.mcap", ImportStatus: statuses[listings]})
+				listings++
+			}
+			json.NewEncoder(w).Encode(recordings)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	})
+	return client
+}
+
+func TestImportRecordingWaitsForImport(t *testing.T) {
+	client := newImportServer(t, "other", ImportStatusPending, ImportStatusComplete)
+
+	recording, err := client.ImportRecording(context.Background(), ImportRecordingRequest{
+		CreateUploadRequest: CreateUploadRequest{Filename: "scene.mcap", DeviceID: "dev_1", Key: "seed"},
+		PollInterval:        time.Millisecond,
+	}, strings.NewReader("mcap content"))
+	if err != nil {
+		t.Fatalf("Failed to import recording: %v", err)
+	}
+	if recording.ID != "rec_new" {
+		t.Fatalf("Expected the new recording, got %s", recording.ID)
+	}
+}
+
+func TestImportRecordingFails(t *testing.T) {
+	client := newImportServer(t, "other", ImportStatusFailed)
+
+	_, err := client.ImportRecording(context.Background(), ImportRecordingRequest{
+		CreateUploadRequest: CreateUploadRequest{Filename: "scene.mcap", DeviceID: "dev_1", Key: "seed"},
+		PollInterval:        time.Millisecond,
+	}, strings.NewReader("mcap content"))
+
+	var importErr *ImportError
+	if !errors.As(err, &importErr) || importErr.Recording.ID != "rec_new" {
+		t.Fatalf("Expected an import error for the new recording, got %v", err)
+	}
+}
+
+func TestImportRecordingWithExistingKey(t *testing.T) {
+	// the upload is deduplicated, so no new recording appears
+	client := newImportServer(t, "seed")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	recording, err := client.ImportRecording(ctx, ImportRecordingRequest{
+		CreateUploadRequest: CreateUploadRequest{Filename: "scene.mcap", DeviceID: "dev_1", Key: "seed"},
+		PollInterval:        time.Millisecond,
+	}, strings.NewReader("mcap content"))
+	if err != nil {
+		t.Fatalf("Failed to import recording: %v", err)
+	}
+	if recording.ID != "rec_old" {
+		t.Fatalf("Expected the existing recording, got %s", recording.ID)
+	}
+}
+
+func TestUploadFileRetriesFromDisk(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "scene.mcap")
+	if err := os.WriteFile(name, []byte("mcap content"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	attempts := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		content, _ := io.ReadAll(r.Body)
+		if string(content) != "mcap content" {
+			t.Errorf("Unexpected upload %q", content)
+		}
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	if err := client.UploadFile(context.Background(), client.BaseURL+"/signed?signature=abc", f); err != nil {
+		t.Fatalf("Failed to upload file: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("Expected the upload to succeed on the second attempt, got %d attempts", attempts)
+	}
+}
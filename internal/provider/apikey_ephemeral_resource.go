@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ ephemeral.EphemeralResource = &ApikeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ApikeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ApikeyEphemeralResource{}
+
+func NewApikeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ApikeyEphemeralResource{}
+}
+
+// ApikeyEphemeralResource creates a short-lived API key that only exists while
+// Terraform runs. Its secret is never written to the plan or state.
+type ApikeyEphemeralResource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// ApikeyEphemeralResourceModel describes the ephemeral resource data model.
+type ApikeyEphemeralResourceModel struct {
+	Label             types.String `tfsdk:"label"`
+	Capabilities      types.Set    `tfsdk:"capabilities"`
+	CapabilityPresets types.Set    `tfsdk:"capability_presets"`
+	Id                types.String `tfsdk:"id"`
+	Secret            types.String `tfsdk:"secret"`
+}
+
+func (r *ApikeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikey"
+}
+
+func (r *ApikeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Short-lived API key which is deleted again when Terraform finishes. Every plan and apply creates a new key, because Foxglove never returns the secret of an existing key again.",
+		Attributes: map[string]schema.Attribute{
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The human-readable label for this key.",
+				Required:            true,
+			},
+			"capabilities": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Capabilities of this key, such as `devices.list` or `data.upload`. Includes the capabilities of `capability_presets`.",
+				Validators: []validator.Set{
+					capabilitiesValidator{},
+				},
+			},
+			"capability_presets": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Named sets of capabilities added to `capabilities`. One of " + presetList() + ".",
+				Validators: []validator.Set{
+					capabilityPresetsValidator{},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Opaque identifier",
+			},
+			"secret": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret token",
+			},
+		},
+	}
+}
+
+func (r *ApikeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.foxgloveClient = foxgloveClient
+}
+
+func (r *ApikeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ApikeyEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	capabilities := expandCapabilities(stringSetElements(data.Capabilities), stringSetElements(data.CapabilityPresets))
+	if len(capabilities) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("capabilities"), "Missing capabilities",
+			"At least one of capabilities or capability_presets must be set.")
+		return
+	}
+
+	apiKey, err := r.foxgloveClient.CreateAPIKey(ctx, foxglove.CreateAPIKeyRequest{
+		Label:        data.Label.ValueString(),
+		Capabilities: capabilities,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create apiKey", err.Error())
+		return
+	}
+
+	// Remember the key so that Close can delete it
+	id, _ := json.Marshal(apiKey.ID)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "id", id)...)
+
+	trueCapabilities, diags := types.SetValueFrom(ctx, types.StringType, apiKey.Capabilities)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &ApikeyEphemeralResourceModel{
+		Label:             types.StringValue(apiKey.Label),
+		Capabilities:      trueCapabilities,
+		CapabilityPresets: data.CapabilityPresets,
+		Id:                types.StringValue(apiKey.ID),
+		Secret:            types.StringValue(apiKey.SecretToken),
+	})...)
+}
+
+func (r *ApikeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	idJSON, diags := req.Private.GetKey(ctx, "id")
+	resp.Diagnostics.Append(diags...)
+
+	var id string
+	if err := json.Unmarshal(idJSON, &id); err != nil {
+		resp.Diagnostics.AddError("failed to read apiKey id", err.Error())
+		return
+	}
+
+	err := r.foxgloveClient.DeleteAPIKey(ctx, id)
+	if err != nil && !foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete apiKey", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted short-lived apiKey", map[string]interface{}{"id": id})
+}
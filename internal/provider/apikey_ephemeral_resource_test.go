@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestApikeyEphemeralResourceOpenClose(t *testing.T) {
+	ctx := context.Background()
+
+	created, deleted := 0, []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api-keys":
+			created++
+			var req foxglove.CreateAPIKeyRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			json.NewEncoder(w).Encode(foxglove.CreateAPIKeyResponse{
+				ID:           "key_1",
+				Label:        req.Label,
+				Capabilities: req.Capabilities,
+				SecretToken:  "fox_sk_secret",
+			})
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.Path)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := foxglove.NewClient("test")
+	client.BaseURL = server.URL
+	r := &ApikeyEphemeralResource{foxgloveClient: client}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	config.Set(ctx, &ApikeyEphemeralResourceModel{
+		Label:             types.StringValue("CI run"),
+		Capabilities:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("devices.list")}),
+		CapabilityPresets: types.SetNull(types.StringType),
+		Id:                types.StringNull(),
+		Secret:            types.StringNull(),
+	})
+
+	openResp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema}}
+	// the private state type is internal to the framework, so it is created through reflection
+	private := reflect.ValueOf(openResp).Elem().FieldByName("Private")
+	private.Set(reflect.New(private.Type().Elem()))
+
+	r.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, openResp)
+	if openResp.Diagnostics.HasError() {
+		t.Fatalf("Open failed: %v", openResp.Diagnostics)
+	}
+
+	var result ApikeyEphemeralResourceModel
+	openResp.Result.Get(ctx, &result)
+	if result.Id.ValueString() != "key_1" || result.Secret.ValueString() != "fox_sk_secret" {
+		t.Errorf("Unexpected result %+v", result)
+	}
+	if created != 1 {
+		t.Errorf("Expected Open to create a new key, got %d", created)
+	}
+
+	closeResp := &ephemeral.CloseResponse{}
+	r.Close(ctx, ephemeral.CloseRequest{Private: openResp.Private}, closeResp)
+	if closeResp.Diagnostics.HasError() {
+		t.Fatalf("Close failed: %v", closeResp.Diagnostics)
+	}
+	if len(deleted) != 1 || deleted[0] != "/api-keys/key_1" {
+		t.Errorf("Expected Close to delete the key, got %v", deleted)
+	}
+}
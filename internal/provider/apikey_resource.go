@@ -11,13 +11,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &ApikeyResource{}
 var _ resource.ResourceWithImportState = &ApikeyResource{}
+var _ resource.ResourceWithValidateConfig = &ApikeyResource{}
+var _ resource.ResourceWithModifyPlan = &ApikeyResource{}
 
 func NewApikeyResource() resource.Resource {
 	return &ApikeyResource{}
@@ -30,10 +34,17 @@ type ApikeyResource struct {
 
 // ApikeyResourceModel describes the resource data model.
 type ApikeyResourceModel struct {
-	Label        types.String `tfsdk:"label"`
-	Capabilities types.List   `tfsdk:"capabilities"`
-	Id           types.String `tfsdk:"id"`
-	Secret       types.String `tfsdk:"secret"`
+	Label             types.String `tfsdk:"label"`
+	Capabilities      types.Set    `tfsdk:"capabilities"`
+	CapabilityPresets types.Set    `tfsdk:"capability_presets"`
+	Id                types.String `tfsdk:"id"`
+	Secret            types.String `tfsdk:"secret"`
+	PgpKey            types.String `tfsdk:"pgp_key"`
+	EncryptedSecret   types.String `tfsdk:"encrypted_secret"`
+	KeyFingerprint    types.String `tfsdk:"key_fingerprint"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	LastSeenAt        types.String `tfsdk:"last_seen_at"`
 }
 
 func (akr *ApikeyResourceModel) CapabilitiesValue() []string {
@@ -57,10 +68,22 @@ func (r *ApikeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:            true,
 				PlanModifiers:       []planmodifier.String{},
 			},
-			"capabilities": schema.ListAttribute{
+			"capabilities": schema.SetAttribute{
 				ElementType:         types.StringType,
-				Required:            true,
-				MarkdownDescription: "Capabilities of this key",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Capabilities of this key, such as `devices.list` or `data.upload`. Includes the capabilities of `capability_presets`.",
+				Validators: []validator.Set{
+					capabilitiesValidator{},
+				},
+			},
+			"capability_presets": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Named sets of capabilities added to `capabilities`. One of " + presetList() + ".",
+				Validators: []validator.Set{
+					capabilityPresetsValidator{},
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -71,7 +94,49 @@ func (r *ApikeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"secret": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "The secret token",
+				Sensitive:           true,
+				MarkdownDescription: "The secret token. Empty if `pgp_key` is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pgp_key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: pgpKeyDescription("secret", "encrypted_secret"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"encrypted_secret": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The secret token encrypted with `pgp_key`, base64 encoded",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fingerprint of the PGP key used to encrypt the secret token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the key can be used to authenticate. Set to `false` to disable a key without deleting it. Defaults to `true`.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Creation time of the key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_seen_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time the key was last used, empty if it was never used",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -80,6 +145,49 @@ func (r *ApikeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *ApikeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ApikeyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Capabilities.IsNull() && data.CapabilityPresets.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("capabilities"), "Missing capabilities",
+			"At least one of capabilities or capability_presets must be set.")
+	}
+
+	if !data.PgpKey.IsNull() && !data.PgpKey.IsUnknown() {
+		if _, err := readPGPKey(data.PgpKey.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("pgp_key"), "Invalid pgp_key", err.Error())
+		}
+	}
+}
+
+// ModifyPlan expands the capability presets, so that the plan shows the
+// capabilities the key will actually have.
+func (r *ApikeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// the key is being destroyed
+		return
+	}
+
+	var capabilities, presets types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("capabilities"), &capabilities)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("capability_presets"), &presets)...)
+
+	if resp.Diagnostics.HasError() || capabilities.IsUnknown() || presets.IsUnknown() {
+		return
+	}
+
+	expanded, diags := types.SetValueFrom(ctx, types.StringType,
+		expandCapabilities(stringSetElements(capabilities), stringSetElements(presets)))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("capabilities"), expanded)...)
+}
+
 func (r *ApikeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -110,7 +218,7 @@ func (r *ApikeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	newDevice, err := r.foxgloveClient.CreateAPIKey(foxglove.CreateAPIKeyRequest{
+	newDevice, err := r.foxgloveClient.CreateAPIKey(ctx, foxglove.CreateAPIKeyRequest{
 		Label:        data.Label.ValueString(),
 		Capabilities: data.CapabilitiesValue(),
 	})
@@ -119,13 +227,40 @@ func (r *ApikeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("failed to create device "+strings.Join(data.CapabilitiesValue(), ";"), err.Error())
 		return
 	}
-	trueCapabilities, _ := types.ListValueFrom(ctx, types.StringType, newDevice.Capabilities)
+	trueCapabilities, _ := types.SetValueFrom(ctx, types.StringType, newDevice.Capabilities)
+
+	stored, ok := storeSecret(data.PgpKey, "apiKey secret", newDevice.SecretToken, func() error {
+		return r.foxgloveClient.DeleteAPIKey(ctx, newDevice.ID)
+	}, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Keys are always created enabled
+	enabled := true
+	if !data.Enabled.ValueBool() {
+		apiKey, err := r.foxgloveClient.UpdateAPIKey(ctx, newDevice.ID, foxglove.UpdateAPIKeyRequest{
+			Enabled: data.Enabled.ValueBoolPointer(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to disable apiKey", err.Error())
+		} else {
+			enabled = apiKey.Enabled
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &ApikeyResourceModel{
-		Id:           types.StringValue(newDevice.ID),
-		Secret:       types.StringValue(newDevice.SecretToken),
-		Label:        types.StringValue(newDevice.Label),
-		Capabilities: trueCapabilities,
+		Id:                types.StringValue(newDevice.ID),
+		Secret:            stored.Secret,
+		PgpKey:            data.PgpKey,
+		EncryptedSecret:   stored.Encrypted,
+		KeyFingerprint:    stored.KeyFingerprint,
+		Label:             types.StringValue(newDevice.Label),
+		Capabilities:      trueCapabilities,
+		CapabilityPresets: data.CapabilityPresets,
+		Enabled:           types.BoolValue(enabled),
+		CreatedAt:         types.StringValue(newDevice.CreatedAt),
+		LastSeenAt:        types.StringValue(""),
 	})...)
 }
 
@@ -139,8 +274,34 @@ func (r *ApikeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	apiKey, err := r.foxgloveClient.GetAPIKey(ctx, data.Id.ValueString())
+	if foxglove.IsNotFound(err) {
+		// the key was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read apiKey", err.Error())
+		return
+	}
+
+	trueCapabilities, diags := types.SetValueFrom(ctx, types.StringType, apiKey.Capabilities)
+	resp.Diagnostics.Append(diags...)
+
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ApikeyResourceModel{
+		Id:                types.StringValue(apiKey.ID),
+		Secret:            data.Secret,
+		PgpKey:            data.PgpKey,
+		EncryptedSecret:   data.EncryptedSecret,
+		KeyFingerprint:    data.KeyFingerprint,
+		Label:             types.StringValue(apiKey.Label),
+		Capabilities:      trueCapabilities,
+		CapabilityPresets: data.CapabilityPresets,
+		Enabled:           types.BoolValue(apiKey.Enabled),
+		CreatedAt:         types.StringValue(apiKey.CreatedAt),
+		LastSeenAt:        types.StringValue(apiKey.LastSeenAt),
+	})...)
 }
 
 func (r *ApikeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -153,9 +314,10 @@ func (r *ApikeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	apiKey, err := r.foxgloveClient.UpdateAPIKey(data.Id.ValueString(), foxglove.UpdateAPIKeyRequest{
+	apiKey, err := r.foxgloveClient.UpdateAPIKey(ctx, data.Id.ValueString(), foxglove.UpdateAPIKeyRequest{
 		Label:        data.Label.ValueString(),
 		Capabilities: data.CapabilitiesValue(),
+		Enabled:      data.Enabled.ValueBoolPointer(),
 	})
 
 	if err != nil {
@@ -163,14 +325,21 @@ func (r *ApikeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	trueCapabilities, _ := types.ListValueFrom(ctx, types.StringType, apiKey.Capabilities)
+	trueCapabilities, _ := types.SetValueFrom(ctx, types.StringType, apiKey.Capabilities)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &ApikeyResourceModel{
-		Label:        types.StringValue(apiKey.Label),
-		Id:           types.StringValue(apiKey.ID),
-		Secret:       data.Secret,
-		Capabilities: trueCapabilities,
+		Label:             types.StringValue(apiKey.Label),
+		Id:                types.StringValue(apiKey.ID),
+		Secret:            data.Secret,
+		PgpKey:            data.PgpKey,
+		EncryptedSecret:   data.EncryptedSecret,
+		KeyFingerprint:    data.KeyFingerprint,
+		Capabilities:      trueCapabilities,
+		CapabilityPresets: data.CapabilityPresets,
+		Enabled:           types.BoolValue(apiKey.Enabled),
+		CreatedAt:         types.StringValue(apiKey.CreatedAt),
+		LastSeenAt:        data.LastSeenAt,
 	})...)
 }
 
@@ -184,8 +353,8 @@ func (r *ApikeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.foxgloveClient.DeleteAPIKey(data.Id.ValueString())
-	if err != nil {
+	err := r.foxgloveClient.DeleteAPIKey(ctx, data.Id.ValueString())
+	if err != nil && !foxglove.IsNotFound(err) {
 		resp.Diagnostics.AddError("failed to delete apiKey", err.Error())
 		return
 	}
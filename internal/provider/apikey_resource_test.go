@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apikeyModel returns the model of an enabled key_1 labelled "robots".
+func apikeyModel() *ApikeyResourceModel {
+	return &ApikeyResourceModel{
+		Label:             types.StringValue("robots"),
+		Capabilities:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("devices.list")}),
+		CapabilityPresets: types.SetNull(types.StringType),
+		Id:                types.StringValue("key_1"),
+		Secret:            types.StringValue("fox_sk_secret"),
+		PgpKey:            types.StringNull(),
+		EncryptedSecret:   types.StringNull(),
+		KeyFingerprint:    types.StringNull(),
+		Enabled:           types.BoolValue(true),
+		CreatedAt:         types.StringValue("2024-01-01T00:00:00Z"),
+		LastSeenAt:        types.StringValue(""),
+	}
+}
+
+func TestApikeyResourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		apiKeys     []foxglove.ListAPIKeyResponse
+		wantRemoved bool
+	}{
+		"changed outside of terraform": {apiKeys: []foxglove.ListAPIKeyResponse{
+			{ID: "key_0", Label: "other"},
+			{ID: "key_1", Label: "fleet", Capabilities: []string{"devices.list", "data.upload"}, Enabled: false, CreatedAt: "2024-01-01T00:00:00Z", LastSeenAt: "2024-06-01T00:00:00Z"},
+		}},
+		"deleted outside of terraform": {apiKeys: []foxglove.ListAPIKeyResponse{{ID: "key_0", Label: "other"}}, wantRemoved: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" || r.URL.Path != "/api-keys" {
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+					http.Error(w, "unexpected request", http.StatusNotFound)
+					return
+				}
+				if r.URL.Query().Get("offset") != "0" {
+					json.NewEncoder(w).Encode([]foxglove.ListAPIKeyResponse{})
+					return
+				}
+				json.NewEncoder(w).Encode(tc.apiKeys)
+			})
+			r := &ApikeyResource{foxgloveClient: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := state.Set(ctx, apikeyModel()); diags.HasError() {
+				t.Fatalf("failed to build state: %v", diags)
+			}
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if removed := resp.State.Raw.IsNull(); removed != tc.wantRemoved {
+				t.Fatalf("expected removed to be %v, got %v", tc.wantRemoved, removed)
+			}
+			if tc.wantRemoved {
+				return
+			}
+
+			var data ApikeyResourceModel
+			resp.State.Get(ctx, &data)
+			if data.Label.ValueString() != "fleet" || data.Enabled.ValueBool() || len(data.Capabilities.Elements()) != 2 {
+				t.Errorf("expected label, capabilities and enabled to be refreshed, got %+v", data)
+			}
+			if data.Secret.ValueString() != "fox_sk_secret" || data.LastSeenAt.ValueString() != "2024-06-01T00:00:00Z" {
+				t.Errorf("unexpected state %+v", data)
+			}
+		})
+	}
+}
+
+func TestApikeyResourceCreateEnabled(t *testing.T) {
+	ctx := context.Background()
+
+	for _, enabled := range []bool{true, false} {
+		t.Run(fmt.Sprintf("enabled=%v", enabled), func(t *testing.T) {
+			var update map[string]interface{}
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "POST" && r.URL.Path == "/api-keys":
+					json.NewEncoder(w).Encode(foxglove.CreateAPIKeyResponse{ID: "key_1", Label: "robots", Capabilities: []string{"devices.list"}, SecretToken: "fox_sk_secret"})
+				case r.Method == "PATCH" && r.URL.Path == "/api-keys/key_1":
+					json.NewDecoder(r.Body).Decode(&update)
+					json.NewEncoder(w).Encode(foxglove.UpdateAPIKeyResponse{ID: "key_1", Label: "robots", Enabled: false})
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+					http.Error(w, "unexpected request", http.StatusNotFound)
+				}
+			})
+			r := &ApikeyResource{foxgloveClient: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			model := apikeyModel()
+			model.Enabled = types.BoolValue(enabled)
+			model.Id = types.StringUnknown()
+			model.Secret = types.StringUnknown()
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, model); diags.HasError() {
+				t.Fatalf("failed to build plan: %v", diags)
+			}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			// keys are created enabled, so only disabled keys are updated
+			if enabled && update != nil {
+				t.Errorf("expected no update of an enabled key, got %v", update)
+			}
+			if !enabled && update["enabled"] != false {
+				t.Errorf("expected the key to be disabled after creation, got %v", update)
+			}
+			var data ApikeyResourceModel
+			resp.State.Get(ctx, &data)
+			if data.Enabled.ValueBool() != enabled {
+				t.Errorf("expected enabled to be %v, got %s", enabled, data.Enabled)
+			}
+		})
+	}
+}
+
+func TestApikeyResourceUpdateEnabled(t *testing.T) {
+	ctx := context.Background()
+
+	var update foxglove.UpdateAPIKeyRequest
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/api-keys/key_1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			http.Error(w, "unexpected request", http.StatusNotFound)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&update)
+		json.NewEncoder(w).Encode(foxglove.UpdateAPIKeyResponse{ID: "key_1", Label: "robots", Capabilities: []string{"devices.list"}, Enabled: false})
+	})
+	r := &ApikeyResource{foxgloveClient: client}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, apikeyModel()); diags.HasError() {
+		t.Fatalf("failed to build state: %v", diags)
+	}
+	model := apikeyModel()
+	model.Enabled = types.BoolValue(false)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, model); diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if update.Enabled == nil || *update.Enabled {
+		t.Errorf("expected enabled = false to be sent, got %v", update.Enabled)
+	}
+	var data ApikeyResourceModel
+	resp.State.Get(ctx, &data)
+	if data.Enabled.ValueBool() {
+		t.Errorf("expected the key to be disabled, got %s", data.Enabled)
+	}
+}
@@ -0,0 +1,508 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ApikeyRotationResource{}
+var _ resource.ResourceWithValidateConfig = &ApikeyRotationResource{}
+var _ resource.ResourceWithModifyPlan = &ApikeyRotationResource{}
+
+func NewApikeyRotationResource() resource.Resource {
+	return &ApikeyRotationResource{}
+}
+
+// ApikeyRotationResource manages an API key that is replaced by a successor
+// key once it reaches a configured age. The previous key stays valid for an
+// overlap window so that its users can switch over to the new secret.
+type ApikeyRotationResource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// ApikeyRotationResourceModel describes the resource data model.
+type ApikeyRotationResourceModel struct {
+	Label             types.String `tfsdk:"label"`
+	Capabilities      types.Set    `tfsdk:"capabilities"`
+	CapabilityPresets types.Set    `tfsdk:"capability_presets"`
+	RotateAfter       types.String `tfsdk:"rotate_after"`
+	Overlap           types.String `tfsdk:"overlap"`
+	RotationTrigger   types.Map    `tfsdk:"rotation_trigger"`
+	Id                types.String `tfsdk:"id"`
+	RotatedAt         types.String `tfsdk:"rotated_at"`
+	RotateAt          types.String `tfsdk:"rotate_at"`
+	CurrentId         types.String `tfsdk:"current_id"`
+	CurrentSecret     types.String `tfsdk:"current_secret"`
+	PreviousId        types.String `tfsdk:"previous_id"`
+	PreviousSecret    types.String `tfsdk:"previous_secret"`
+	PreviousExpiresAt types.String `tfsdk:"previous_expires_at"`
+}
+
+func (m *ApikeyRotationResourceModel) CapabilitiesValue() []string {
+	return stringSetElements(m.Capabilities)
+}
+
+// rotateAfterValue and overlapValue parse durations already checked by ValidateConfig.
+func (m *ApikeyRotationResourceModel) rotateAfterValue() time.Duration {
+	duration, _ := time.ParseDuration(m.RotateAfter.ValueString())
+	return duration
+}
+
+func (m *ApikeyRotationResourceModel) overlapValue() time.Duration {
+	duration, _ := time.ParseDuration(m.Overlap.ValueString())
+	return duration
+}
+
+func (r *ApikeyRotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikey_rotation"
+}
+
+func (r *ApikeyRotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	useState := []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "API key which is rotated periodically",
+		Attributes: map[string]schema.Attribute{
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The human-readable label of the keys.",
+				Required:            true,
+			},
+			"capabilities": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Capabilities of the keys, such as `devices.list` or `data.upload`. Includes the capabilities of `capability_presets`.",
+				Validators: []validator.Set{
+					capabilitiesValidator{},
+				},
+			},
+			"capability_presets": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Named sets of capabilities added to `capabilities`. One of " + presetList() + ".",
+				Validators: []validator.Set{
+					capabilityPresetsValidator{},
+				},
+			},
+			"rotate_after": schema.StringAttribute{
+				MarkdownDescription: "Age after which the current key is replaced by a new one, as a duration like `720h`.",
+				Required:            true,
+			},
+			"overlap": schema.StringAttribute{
+				MarkdownDescription: "How long the previous key stays valid after a rotation, as a duration like `24h`. Defaults to `24h`. With `0s` the previous key is deleted right away.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("24h"),
+			},
+			"rotation_trigger": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values which rotate the key immediately when changed.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Opaque identifier, the ID of the first key",
+				PlanModifiers:       useState,
+			},
+			"rotated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Creation time of the current key in RFC 3339 format",
+			},
+			"rotate_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time after which the next apply rotates the key, in RFC 3339 format",
+			},
+			"current_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the current key",
+			},
+			"current_secret": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret token of the current key",
+			},
+			"previous_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the previous key while it is still valid",
+			},
+			"previous_secret": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret token of the previous key while it is still valid",
+			},
+			"previous_expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time after which the next apply deletes the previous key, in RFC 3339 format",
+			},
+		},
+	}
+}
+
+func (r *ApikeyRotationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ApikeyRotationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Capabilities.IsNull() && data.CapabilityPresets.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("capabilities"), "Missing capabilities",
+			"At least one of capabilities or capability_presets must be set.")
+	}
+
+	if !data.RotateAfter.IsUnknown() {
+		if rotateAfter, err := time.ParseDuration(data.RotateAfter.ValueString()); err != nil || rotateAfter <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("rotate_after"), "Invalid rotate_after",
+				fmt.Sprintf("Expected a positive duration such as \"720h\", got %q.", data.RotateAfter.ValueString()))
+		}
+	}
+	if !data.Overlap.IsNull() && !data.Overlap.IsUnknown() {
+		parseDuration(&resp.Diagnostics, path.Root("overlap"), data.Overlap.ValueString(), 0)
+	}
+}
+
+// ModifyPlan decides whether the next apply rotates the key or retires the
+// previous key. Both only happen when Terraform runs, so rotate_at and
+// previous_expires_at are the earliest times at which this can happen.
+func (r *ApikeyRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// the keys are being destroyed
+		return
+	}
+
+	var capabilities, presets types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("capabilities"), &capabilities)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("capability_presets"), &presets)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !capabilities.IsUnknown() && !presets.IsUnknown() {
+		expanded, diags := types.SetValueFrom(ctx, types.StringType,
+			expandCapabilities(stringSetElements(capabilities), stringSetElements(presets)))
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("capabilities"), expanded)...)
+	}
+
+	if req.State.Raw.IsNull() {
+		// the keys are being created
+		return
+	}
+
+	var plan, state ApikeyRotationResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+	rotatedAt, _ := time.Parse(time.RFC3339, state.RotatedAt.ValueString())
+
+	// Read clears current_id if the current key was deleted outside of terraform
+	rotate := state.CurrentId.IsNull() || !plan.RotationTrigger.Equal(state.RotationTrigger)
+	if !plan.RotateAfter.IsUnknown() {
+		rotateAt := rotatedAt.Add(plan.rotateAfterValue())
+		plan.RotateAt = types.StringValue(rotateAt.Format(time.RFC3339))
+		rotate = rotate || !now.Before(rotateAt)
+	}
+
+	if rotate {
+		plan.RotatedAt = types.StringUnknown()
+		plan.RotateAt = types.StringUnknown()
+		plan.CurrentId = types.StringUnknown()
+		plan.CurrentSecret = types.StringUnknown()
+		plan.PreviousId = types.StringUnknown()
+		plan.PreviousSecret = types.StringUnknown()
+		plan.PreviousExpiresAt = types.StringUnknown()
+	} else {
+		plan.RotatedAt = state.RotatedAt
+		plan.CurrentId = state.CurrentId
+		plan.CurrentSecret = state.CurrentSecret
+		plan.PreviousId = state.PreviousId
+		plan.PreviousSecret = state.PreviousSecret
+		plan.PreviousExpiresAt = state.PreviousExpiresAt
+
+		// the previous key is kept if deleting it fails
+		previousExpiresAt, err := time.Parse(time.RFC3339, state.PreviousExpiresAt.ValueString())
+		if !state.PreviousId.IsNull() && err == nil && !now.Before(previousExpiresAt) {
+			plan.PreviousId = types.StringUnknown()
+			plan.PreviousSecret = types.StringUnknown()
+			plan.PreviousExpiresAt = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ApikeyRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.foxgloveClient = foxgloveClient
+}
+
+func (r *ApikeyRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ApikeyRotationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := r.foxgloveClient.CreateAPIKey(ctx, foxglove.CreateAPIKeyRequest{
+		Label:        data.Label.ValueString(),
+		Capabilities: data.CapabilitiesValue(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create apiKey", err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(apiKey.ID)
+	r.setCurrent(ctx, &data, apiKey, time.Now(), &resp.Diagnostics)
+	data.PreviousId = types.StringNull()
+	data.PreviousSecret = types.StringNull()
+	data.PreviousExpiresAt = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setCurrent records apiKey as the current key, created at rotatedAt.
+func (r *ApikeyRotationResource) setCurrent(ctx context.Context, data *ApikeyRotationResourceModel, apiKey *foxglove.CreateAPIKeyResponse, rotatedAt time.Time, diags *diag.Diagnostics) {
+	capabilities, d := types.SetValueFrom(ctx, types.StringType, apiKey.Capabilities)
+	diags.Append(d...)
+
+	data.Label = types.StringValue(apiKey.Label)
+	data.Capabilities = capabilities
+	data.RotatedAt = types.StringValue(rotatedAt.UTC().Format(time.RFC3339))
+	data.RotateAt = types.StringValue(rotatedAt.Add(data.rotateAfterValue()).UTC().Format(time.RFC3339))
+	data.CurrentId = types.StringValue(apiKey.ID)
+	data.CurrentSecret = types.StringValue(apiKey.SecretToken)
+}
+
+func (r *ApikeyRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ApikeyRotationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PreviousId.IsNull() {
+		_, err := r.foxgloveClient.GetAPIKey(ctx, data.PreviousId.ValueString())
+		if foxglove.IsNotFound(err) {
+			data.PreviousId = types.StringNull()
+			data.PreviousSecret = types.StringNull()
+			data.PreviousExpiresAt = types.StringNull()
+		} else if err != nil {
+			resp.Diagnostics.AddError("failed to read previous apiKey", err.Error())
+			return
+		}
+	}
+
+	if !data.CurrentId.IsNull() {
+		current, err := r.foxgloveClient.GetAPIKey(ctx, data.CurrentId.ValueString())
+		if foxglove.IsNotFound(err) {
+			// the current key was deleted outside of terraform
+			data.CurrentId = types.StringNull()
+			data.CurrentSecret = types.StringNull()
+		} else if err != nil {
+			resp.Diagnostics.AddError("failed to read apiKey", err.Error())
+			return
+		} else {
+			capabilities, diags := types.SetValueFrom(ctx, types.StringType, current.Capabilities)
+			resp.Diagnostics.Append(diags...)
+			data.Label = types.StringValue(current.Label)
+			data.Capabilities = capabilities
+		}
+	}
+
+	if data.CurrentId.IsNull() && data.PreviousId.IsNull() {
+		// all keys were deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The previous key is still live, so the resource is kept and the next
+	// apply creates a new current key.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApikeyRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ApikeyRotationResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Label.Equal(state.Label) || !data.Capabilities.Equal(state.Capabilities) {
+		for _, id := range []types.String{state.CurrentId, state.PreviousId} {
+			if id.IsNull() {
+				continue
+			}
+			_, err := r.foxgloveClient.UpdateAPIKey(ctx, id.ValueString(), foxglove.UpdateAPIKeyRequest{
+				Label:        data.Label.ValueString(),
+				Capabilities: data.CapabilitiesValue(),
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("failed to update apiKey", err.Error())
+				return
+			}
+		}
+	}
+
+	if data.CurrentId.IsUnknown() {
+		r.rotate(ctx, &data, state, resp)
+	} else {
+		// the rotate_after duration may have changed
+		rotatedAt, _ := time.Parse(time.RFC3339, data.RotatedAt.ValueString())
+		data.RotateAt = types.StringValue(rotatedAt.Add(data.rotateAfterValue()).UTC().Format(time.RFC3339))
+
+		if data.PreviousId.IsUnknown() {
+			data.PreviousId = types.StringNull()
+			data.PreviousSecret = types.StringNull()
+			data.PreviousExpiresAt = types.StringNull()
+			if err := r.deleteKey(ctx, state.PreviousId.ValueString()); err != nil {
+				// keep the key, so that the next apply deletes it
+				resp.Diagnostics.AddWarning("failed to delete previous apiKey "+state.PreviousId.ValueString(), err.Error())
+				data.PreviousId = state.PreviousId
+				data.PreviousSecret = state.PreviousSecret
+				data.PreviousExpiresAt = state.PreviousExpiresAt
+			}
+		}
+	}
+
+	if data.CurrentId.IsUnknown() {
+		// the successor key was not created, so the prior state still holds
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// rotate creates the successor key and turns the current key into the previous
+// one. Once the successor exists, failures to delete old keys are warnings, and
+// keys which could not be deleted are kept as the previous key, so that the
+// successor is always saved and the next apply deletes them.
+func (r *ApikeyRotationResource) rotate(ctx context.Context, data *ApikeyRotationResourceModel, state ApikeyRotationResourceModel, resp *resource.UpdateResponse) {
+	// Only one previous key is kept. A key still in its overlap window is
+	// retired early, before the successor is created, unless the current key
+	// is gone and the previous key is the only one left.
+	previousId, previousSecret, previousExpiresAt := state.PreviousId, state.PreviousSecret, state.PreviousExpiresAt
+	if !state.PreviousId.IsNull() && !state.CurrentId.IsNull() {
+		if err := r.deleteKey(ctx, state.PreviousId.ValueString()); err != nil {
+			resp.Diagnostics.AddError("failed to delete previous apiKey "+state.PreviousId.ValueString(), err.Error())
+			return
+		}
+		previousId, previousSecret, previousExpiresAt = types.StringNull(), types.StringNull(), types.StringNull()
+	}
+
+	apiKey, err := r.foxgloveClient.CreateAPIKey(ctx, foxglove.CreateAPIKeyRequest{
+		Label:        data.Label.ValueString(),
+		Capabilities: data.CapabilitiesValue(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create successor apiKey", err.Error())
+		return
+	}
+
+	now := time.Now()
+	r.setCurrent(ctx, data, apiKey, now, &resp.Diagnostics)
+
+	if !state.CurrentId.IsNull() {
+		previousId, previousSecret = state.CurrentId, state.CurrentSecret
+		previousExpiresAt = types.StringValue(now.Add(data.overlapValue()).UTC().Format(time.RFC3339))
+		if data.overlapValue() <= 0 {
+			if err := r.deleteKey(ctx, state.CurrentId.ValueString()); err != nil {
+				// keep the key with an expired overlap, so that the next apply deletes it
+				resp.Diagnostics.AddWarning("failed to delete previous apiKey "+state.CurrentId.ValueString(), err.Error())
+			} else {
+				previousId, previousSecret, previousExpiresAt = types.StringNull(), types.StringNull(), types.StringNull()
+			}
+		}
+	}
+	data.PreviousId = previousId
+	data.PreviousSecret = previousSecret
+	data.PreviousExpiresAt = previousExpiresAt
+
+	tflog.Info(ctx, "rotated apiKey", map[string]interface{}{
+		"current_id":  apiKey.ID,
+		"previous_id": previousId.ValueString(),
+	})
+}
+
+// deleteKey deletes the key with the given id. Keys which are already gone
+// are not an error.
+func (r *ApikeyRotationResource) deleteKey(ctx context.Context, id string) error {
+	err := r.foxgloveClient.DeleteAPIKey(ctx, id)
+	if err != nil && !foxglove.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (r *ApikeyRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ApikeyRotationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, id := range []types.String{data.PreviousId, data.CurrentId} {
+		if id.IsNull() {
+			continue
+		}
+		if err := r.deleteKey(ctx, id.ValueString()); err != nil {
+			resp.Diagnostics.AddError("failed to delete apiKey "+id.ValueString(), err.Error())
+		}
+	}
+}
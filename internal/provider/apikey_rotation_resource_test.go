@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestApikeyRotationModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &ApikeyRotationResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	now := time.Now().UTC()
+	timestamp := func(d time.Duration) types.String {
+		return types.StringValue(now.Add(d).Format(time.RFC3339))
+	}
+	trigger := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue(value)})
+	}
+
+	testCases := map[string]struct {
+		rotatedAgo        time.Duration
+		currentDeleted    bool
+		stateTrigger      types.Map
+		planTrigger       types.Map
+		previousExpiresIn *time.Duration
+		wantRotate        bool
+		wantRetire        bool
+	}{
+		"not due":             {rotatedAgo: time.Hour},
+		"due":                 {rotatedAgo: 800 * time.Hour, wantRotate: true},
+		"trigger changed":     {rotatedAgo: time.Hour, stateTrigger: trigger("1"), planTrigger: trigger("2"), wantRotate: true},
+		"trigger unchanged":   {rotatedAgo: time.Hour, stateTrigger: trigger("1"), planTrigger: trigger("1")},
+		"current deleted":     {rotatedAgo: time.Hour, currentDeleted: true, wantRotate: true},
+		"previous in overlap": {rotatedAgo: time.Hour, previousExpiresIn: durationPointer(time.Hour)},
+		"previous expired":    {rotatedAgo: 48 * time.Hour, previousExpiresIn: durationPointer(-time.Hour), wantRetire: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// cases without a trigger leave the zero value, which has no element type
+			stateTrigger, planTrigger := tc.stateTrigger, tc.planTrigger
+			if stateTrigger.ElementType(ctx) == nil {
+				stateTrigger = types.MapNull(types.StringType)
+			}
+			if planTrigger.ElementType(ctx) == nil {
+				planTrigger = types.MapNull(types.StringType)
+			}
+
+			model := ApikeyRotationResourceModel{
+				Label:             types.StringValue("robots"),
+				Capabilities:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("devices.list")}),
+				CapabilityPresets: types.SetNull(types.StringType),
+				RotateAfter:       types.StringValue("720h"),
+				Overlap:           types.StringValue("24h"),
+				RotationTrigger:   stateTrigger,
+				Id:                types.StringValue("key_1"),
+				RotatedAt:         timestamp(-tc.rotatedAgo),
+				RotateAt:          timestamp(720*time.Hour - tc.rotatedAgo),
+				CurrentId:         types.StringValue("key_1"),
+				CurrentSecret:     types.StringValue("secret_1"),
+				PreviousId:        types.StringNull(),
+				PreviousSecret:    types.StringNull(),
+				PreviousExpiresAt: types.StringNull(),
+			}
+			if tc.currentDeleted {
+				model.CurrentId = types.StringNull()
+				model.CurrentSecret = types.StringNull()
+			}
+			if tc.previousExpiresIn != nil {
+				model.PreviousId = types.StringValue("key_0")
+				model.PreviousSecret = types.StringValue("secret_0")
+				model.PreviousExpiresAt = timestamp(*tc.previousExpiresIn)
+			}
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := state.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("failed to build state: %v", diags)
+			}
+			model.RotationTrigger = planTrigger
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("failed to build plan: %v", diags)
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+				Plan:   plan,
+				State:  state,
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var planned ApikeyRotationResourceModel
+			resp.Plan.Get(ctx, &planned)
+			if rotate := planned.CurrentId.IsUnknown(); rotate != tc.wantRotate {
+				t.Errorf("expected rotate to be %v, got %v", tc.wantRotate, rotate)
+			}
+			if retire := planned.PreviousId.IsUnknown() && !tc.wantRotate; retire != tc.wantRetire {
+				t.Errorf("expected retire to be %v, got %v", tc.wantRetire, retire)
+			}
+			if !tc.wantRotate && !tc.wantRetire && !planned.PreviousId.Equal(model.PreviousId) {
+				t.Errorf("expected the previous key to be kept, got %s", planned.PreviousId)
+			}
+		})
+	}
+}
+
+func durationPointer(d time.Duration) *time.Duration {
+	return &d
+}
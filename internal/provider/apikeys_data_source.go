@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ApikeysDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ApikeysDataSource{}
+
+func NewApikeysDataSource() datasource.DataSource {
+	return &ApikeysDataSource{}
+}
+
+// ApikeysDataSource defines the data source implementation.
+type ApikeysDataSource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// ApikeysDataSourceModel describes the data source data model.
+type ApikeysDataSourceModel struct {
+	LabelRegex         types.String              `tfsdk:"label_regex"`
+	CapabilityContains types.String              `tfsdk:"capability_contains"`
+	Enabled            types.Bool                `tfsdk:"enabled"`
+	NotSeenSince       types.String              `tfsdk:"not_seen_since"`
+	Ids                []string                  `tfsdk:"ids"`
+	ApiKeys            []ApikeyDataSourceElement `tfsdk:"api_keys"`
+}
+
+// ApikeyDataSourceElement describes a single key returned by the data source.
+type ApikeyDataSourceElement struct {
+	Id                   types.String `tfsdk:"id"`
+	Label                types.String `tfsdk:"label"`
+	Capabilities         types.Set    `tfsdk:"capabilities"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+	LastSeenAt           types.String `tfsdk:"last_seen_at"`
+	CreatedByOrgMemberId types.String `tfsdk:"created_by_org_member_id"`
+}
+
+func (d *ApikeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikeys"
+}
+
+func (d *ApikeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "API keys",
+		Attributes: map[string]schema.Attribute{
+			"label_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return keys whose label matches this regular expression.",
+			},
+			"capability_contains": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return keys having this capability, such as `devices.delete`.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return enabled or only disabled keys.",
+			},
+			"not_seen_since": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return keys which were not used within this duration, such as `2160h`. Keys which were never used count from their creation time.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Identifiers of the matching keys.",
+			},
+			"api_keys": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching keys.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The identifier of the key.",
+						},
+						"label": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The human-readable label of the key.",
+						},
+						"capabilities": schema.SetAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "Capabilities of the key.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the key can be used to authenticate.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Creation time of the key.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Time of the last update of the key.",
+						},
+						"last_seen_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Time the key was last used, empty if it was never used.",
+						},
+						"created_by_org_member_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The organization member who created the key.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApikeysDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ApikeysDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.LabelRegex.IsNull() && !data.LabelRegex.IsUnknown() {
+		if _, err := regexp.Compile(data.LabelRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("label_regex"), "Invalid label_regex", err.Error())
+		}
+	}
+
+	if !data.NotSeenSince.IsUnknown() {
+		parseDuration(&resp.Diagnostics, path.Root("not_seen_since"), data.NotSeenSince.ValueString(), 0)
+	}
+}
+
+func (d *ApikeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.foxgloveClient = foxgloveClient
+}
+
+func (d *ApikeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApikeysDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// values which were unknown during ValidateConfig are only checked here
+	labelRegex, err := regexp.Compile(data.LabelRegex.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("label_regex"), "Invalid label_regex", err.Error())
+		return
+	}
+	notSeenSince := parseDuration(&resp.Diagnostics, path.Root("not_seen_since"), data.NotSeenSince.ValueString(), 0)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	staleBefore := time.Now().Add(-notSeenSince)
+
+	data.Ids = []string{}
+	data.ApiKeys = []ApikeyDataSourceElement{}
+
+	for apiKey, err := range d.foxgloveClient.AllAPIKeys(ctx, foxglove.PageOptions{}) {
+		if err != nil {
+			resp.Diagnostics.AddError("failed to list apiKeys", err.Error())
+			return
+		}
+
+		if !labelRegex.MatchString(apiKey.Label) {
+			continue
+		}
+		if !data.CapabilityContains.IsNull() && !slices.Contains(apiKey.Capabilities, data.CapabilityContains.ValueString()) {
+			continue
+		}
+		if !data.Enabled.IsNull() && apiKey.Enabled != data.Enabled.ValueBool() {
+			continue
+		}
+		if !data.NotSeenSince.IsNull() && !lastActivity(apiKey).Before(staleBefore) {
+			continue
+		}
+
+		capabilities, diags := types.SetValueFrom(ctx, types.StringType, apiKey.Capabilities)
+		resp.Diagnostics.Append(diags...)
+
+		data.Ids = append(data.Ids, apiKey.ID)
+		data.ApiKeys = append(data.ApiKeys, ApikeyDataSourceElement{
+			Id:                   types.StringValue(apiKey.ID),
+			Label:                types.StringValue(apiKey.Label),
+			Capabilities:         capabilities,
+			Enabled:              types.BoolValue(apiKey.Enabled),
+			CreatedAt:            types.StringValue(apiKey.CreatedAt),
+			UpdatedAt:            types.StringValue(apiKey.UpdatedAt),
+			LastSeenAt:           types.StringValue(apiKey.LastSeenAt),
+			CreatedByOrgMemberId: types.StringValue(apiKey.CreatedByOrgMemberId),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// lastActivity returns when a key was last used, or its creation time if it was never used.
+func lastActivity(apiKey foxglove.ListAPIKeyResponse) time.Time {
+	if lastSeenAt, err := time.Parse(time.RFC3339, apiKey.LastSeenAt); err == nil {
+		return lastSeenAt
+	}
+	createdAt, _ := time.Parse(time.RFC3339, apiKey.CreatedAt)
+	return createdAt
+}
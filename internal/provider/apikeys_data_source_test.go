@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+	"time"
+)
+
+func TestLastActivity(t *testing.T) {
+	createdAt := "2024-01-01T00:00:00Z"
+	lastSeenAt := "2024-06-01T12:00:00Z"
+
+	seen := lastActivity(foxglove.ListAPIKeyResponse{CreatedAt: createdAt, LastSeenAt: lastSeenAt})
+	if want, _ := time.Parse(time.RFC3339, lastSeenAt); !seen.Equal(want) {
+		t.Errorf("expected last seen time %v, got %v", want, seen)
+	}
+
+	neverSeen := lastActivity(foxglove.ListAPIKeyResponse{CreatedAt: createdAt})
+	if want, _ := time.Parse(time.RFC3339, createdAt); !neverSeen.Equal(want) {
+		t.Errorf("expected creation time %v for a key never used, got %v", want, neverSeen)
+	}
+}
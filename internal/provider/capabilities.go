@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiKeyCapabilities lists the capabilities known to be accepted by the Foxglove API.
+var apiKeyCapabilities = []string{
+	"coverage.list",
+	"customProperties.create",
+	"customProperties.delete",
+	"customProperties.list",
+	"customProperties.update",
+	"data.delete",
+	"data.stream",
+	"data.topics.list",
+	"data.upload",
+	"deviceTokens.create",
+	"deviceTokens.delete",
+	"deviceTokens.list",
+	"deviceTokens.update",
+	"devices.create",
+	"devices.delete",
+	"devices.list",
+	"devices.read",
+	"devices.update",
+	"events.create",
+	"events.delete",
+	"events.list",
+	"events.update",
+	"extensions.create",
+	"extensions.delete",
+	"extensions.list",
+	"layouts.create",
+	"layouts.delete",
+	"layouts.list",
+	"layouts.update",
+	"recordingAttachments.download",
+	"recordingAttachments.list",
+	"recordings.delete",
+	"recordings.import",
+	"recordings.list",
+	"recordings.update",
+	"siteTokens.create",
+	"siteTokens.delete",
+	"siteTokens.list",
+	"sites.create",
+	"sites.delete",
+	"sites.list",
+	"sites.update",
+	"topics.list",
+}
+
+// capabilityPresets are shortcuts for commonly used sets of capabilities.
+var capabilityPresets = map[string][]string{
+	"read-only": {
+		"coverage.list",
+		"data.stream",
+		"devices.list",
+		"events.list",
+		"extensions.list",
+		"layouts.list",
+		"recordingAttachments.list",
+		"recordings.list",
+		"sites.list",
+		"topics.list",
+	},
+	"robot-uploader": {
+		"data.upload",
+		"devices.list",
+		"events.create",
+		"recordings.list",
+	},
+	"device-manager": {
+		"deviceTokens.create",
+		"deviceTokens.delete",
+		"deviceTokens.list",
+		"deviceTokens.update",
+		"devices.create",
+		"devices.delete",
+		"devices.list",
+		"devices.update",
+	},
+	"event-annotator": {
+		"devices.list",
+		"events.create",
+		"events.delete",
+		"events.list",
+		"events.update",
+	},
+}
+
+// expandCapabilities returns the sorted union of capabilities and the capabilities of presets.
+func expandCapabilities(capabilities []string, presets []string) []string {
+	expanded := slices.Clone(capabilities)
+	for _, preset := range presets {
+		expanded = append(expanded, capabilityPresets[preset]...)
+	}
+	slices.Sort(expanded)
+	return slices.Compact(expanded)
+}
+
+// stringSetElements returns the known string elements of a set.
+func stringSetElements(set types.Set) []string {
+	elements := []string{}
+	for _, element := range set.Elements() {
+		value := element.(types.String)
+		if !value.IsUnknown() && !value.IsNull() {
+			elements = append(elements, value.ValueString())
+		}
+	}
+	return elements
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// closestMatch returns the candidate closest to value if it is likely a typo of it.
+func closestMatch(value string, candidates []string) (string, bool) {
+	best, bestDistance := "", -1
+	for _, candidate := range candidates {
+		distance := levenshtein(strings.ToLower(value), strings.ToLower(candidate))
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best, bestDistance >= 0 && bestDistance <= max(2, len(value)/4)
+}
+
+var _ validator.Set = capabilitiesValidator{}
+
+// capabilitiesValidator checks capabilities against the known vocabulary.
+// Likely typos are errors, other unknown capabilities only warnings so that
+// capabilities added to Foxglove later can be used right away.
+type capabilitiesValidator struct{}
+
+func (v capabilitiesValidator) Description(ctx context.Context) string {
+	return "capabilities must be valid Foxglove API key capabilities"
+}
+
+func (v capabilitiesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v capabilitiesValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	for _, capability := range stringSetElements(req.ConfigValue) {
+		if slices.Contains(apiKeyCapabilities, capability) {
+			continue
+		}
+		if suggestion, ok := closestMatch(capability, apiKeyCapabilities); ok {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid capability",
+				fmt.Sprintf("%q is not a Foxglove API key capability. Did you mean %q?", capability, suggestion))
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown capability",
+			fmt.Sprintf("%q is not a known Foxglove API key capability and may be rejected by the API. Known capabilities are: %s.",
+				capability, strings.Join(apiKeyCapabilities, ", ")))
+	}
+}
+
+var _ validator.Set = capabilityPresetsValidator{}
+
+// capabilityPresetsValidator checks that only known presets are used.
+type capabilityPresetsValidator struct{}
+
+func (v capabilityPresetsValidator) Description(ctx context.Context) string {
+	return "capability presets must be one of: " + strings.Join(capabilityPresetNames(), ", ")
+}
+
+func (v capabilityPresetsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v capabilityPresetsValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	names := capabilityPresetNames()
+	for _, preset := range stringSetElements(req.ConfigValue) {
+		if _, ok := capabilityPresets[preset]; ok {
+			continue
+		}
+		detail := fmt.Sprintf("%q is not a capability preset. Valid presets are: %s.", preset, strings.Join(names, ", "))
+		if suggestion, ok := closestMatch(preset, names); ok {
+			detail = fmt.Sprintf("%q is not a capability preset. Did you mean %q?", preset, suggestion)
+		}
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid capability preset", detail)
+	}
+}
+
+// presetList formats the preset names for schema descriptions.
+func presetList() string {
+	names := []string{}
+	for _, name := range capabilityPresetNames() {
+		names = append(names, "`"+name+"`")
+	}
+	return strings.Join(names, ", ")
+}
+
+func capabilityPresetNames() []string {
+	return slices.Sorted(maps.Keys(capabilityPresets))
+}
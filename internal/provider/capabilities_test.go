@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpandCapabilities(t *testing.T) {
+	expanded := expandCapabilities([]string{"events.list", "data.upload"}, []string{"robot-uploader"})
+	expected := []string{"data.upload", "devices.list", "events.create", "events.list", "recordings.list"}
+	if !slices.Equal(expanded, expected) {
+		t.Fatalf("Expected %v, got %v", expected, expanded)
+	}
+}
+
+func TestPresetCapabilitiesAreKnown(t *testing.T) {
+	for name, capabilities := range capabilityPresets {
+		for _, capability := range capabilities {
+			if !slices.Contains(apiKeyCapabilities, capability) {
+				t.Errorf("Preset %s uses unknown capability %s", name, capability)
+			}
+		}
+	}
+}
+
+func TestCapabilitiesValidator(t *testing.T) {
+	tests := []struct {
+		capability string
+		errors     int
+		warnings   int
+	}{
+		{"devices.list", 0, 0},
+		{"devices.lsit", 1, 0},
+		{"Recordings.Delete", 1, 0},
+		{"robots.teleport", 0, 1},
+	}
+
+	for _, test := range tests {
+		value, _ := types.SetValueFrom(context.Background(), types.StringType, []string{test.capability})
+		resp := &validator.SetResponse{}
+		capabilitiesValidator{}.ValidateSet(context.Background(), validator.SetRequest{
+			Path:        path.Root("capabilities"),
+			ConfigValue: value,
+		}, resp)
+
+		if resp.Diagnostics.ErrorsCount() != test.errors || resp.Diagnostics.WarningsCount() != test.warnings {
+			t.Errorf("%s: expected %d errors and %d warnings, got %v", test.capability, test.errors, test.warnings, resp.Diagnostics)
+		}
+	}
+}
+
+func TestClosestMatch(t *testing.T) {
+	suggestion, ok := closestMatch("robot-upload", capabilityPresetNames())
+	if !ok || suggestion != "robot-uploader" {
+		t.Fatalf("Expected robot-uploader to be suggested, got %q", suggestion)
+	}
+	if _, ok := closestMatch("everything", capabilityPresetNames()); ok {
+		t.Fatal("Expected no suggestion")
+	}
+}
@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CustomPropertyResource{}
+var _ resource.ResourceWithImportState = &CustomPropertyResource{}
+var _ resource.ResourceWithValidateConfig = &CustomPropertyResource{}
+
+func NewCustomPropertyResource() resource.Resource {
+	return &CustomPropertyResource{}
+}
+
+// CustomPropertyResource defines the resource implementation.
+type CustomPropertyResource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// CustomPropertyResourceModel describes the resource data model.
+type CustomPropertyResourceModel struct {
+	Key       types.String `tfsdk:"key"`
+	Label     types.String `tfsdk:"label"`
+	ValueType types.String `tfsdk:"value_type"`
+	Values    types.List   `tfsdk:"values"`
+	Id        types.String `tfsdk:"id"`
+}
+
+func (cp *CustomPropertyResourceModel) ValuesValue() []string {
+	values := []string{}
+	for _, value := range cp.Values.Elements() {
+		values = append(values, value.(types.String).ValueString())
+	}
+	return values
+}
+
+func (r *CustomPropertyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_property"
+}
+
+func (r *CustomPropertyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom device property",
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The key of the property in the `properties` of devices. Changing it replaces the property.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The human-readable label of the property.",
+			},
+			"value_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of the property values, one of `string`, `number`, `boolean` or `enum`. Changing it replaces the property.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "The allowed values of an `enum` property.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Opaque identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomPropertyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CustomPropertyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.ValueType.IsUnknown() {
+		return
+	}
+
+	valueType := data.ValueType.ValueString()
+	if !slices.Contains(propertyValueTypes, valueType) {
+		resp.Diagnostics.AddAttributeError(path.Root("value_type"), "Invalid value_type",
+			fmt.Sprintf("value_type must be one of %s, got %q.", strings.Join(propertyValueTypes, ", "), valueType))
+		return
+	}
+
+	if data.Values.IsUnknown() {
+		return
+	}
+	if valueType == foxglove.PropertyTypeEnum && len(data.Values.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("values"), "Missing values",
+			"values must list the allowed values of an enum property.")
+	}
+	if valueType != foxglove.PropertyTypeEnum && !data.Values.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("values"), "Invalid values",
+			"values can only be set for an enum property.")
+	}
+}
+
+func (r *CustomPropertyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.foxgloveClient = foxgloveClient
+}
+
+func (r *CustomPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomPropertyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	property, err := r.foxgloveClient.CreateCustomProperty(ctx, foxglove.CreateCustomPropertyRequest{
+		Key:          data.Key.ValueString(),
+		Label:        data.Label.ValueString(),
+		ResourceType: foxglove.CustomPropertyResourceDevice,
+		ValueType:    data.ValueType.ValueString(),
+		Values:       data.ValuesValue(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create custom property", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, customPropertyModel(ctx, property, data))...)
+}
+
+func (r *CustomPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomPropertyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	property, err := r.foxgloveClient.GetCustomProperty(ctx, data.Id.ValueString())
+	if foxglove.IsNotFound(err) {
+		// the property was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read custom property", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, customPropertyModel(ctx, property, data))...)
+}
+
+func (r *CustomPropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomPropertyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	property, err := r.foxgloveClient.UpdateCustomProperty(ctx, data.Id.ValueString(), foxglove.UpdateCustomPropertyRequest{
+		Label:  data.Label.ValueString(),
+		Values: data.ValuesValue(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update custom property", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, customPropertyModel(ctx, property, data))...)
+}
+
+func (r *CustomPropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomPropertyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.foxgloveClient.DeleteCustomProperty(ctx, data.Id.ValueString())
+	if err != nil && !foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete custom property", err.Error())
+		return
+	}
+}
+
+func (r *CustomPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// customPropertyModel builds the state of a custom property. Properties
+// without enum values keep a null list if prior has none.
+func customPropertyModel(ctx context.Context, property *foxglove.CustomPropertyResponse, prior CustomPropertyResourceModel) *CustomPropertyResourceModel {
+	values := types.ListNull(types.StringType)
+	if len(property.Values) > 0 || !prior.Values.IsNull() {
+		// a nil slice would become a null list
+		propertyValues := property.Values
+		if propertyValues == nil {
+			propertyValues = []string{}
+		}
+		values, _ = types.ListValueFrom(ctx, types.StringType, propertyValues)
+	}
+
+	return &CustomPropertyResourceModel{
+		Key:       types.StringValue(property.Key),
+		Label:     types.StringValue(property.Label),
+		ValueType: types.StringValue(property.ValueType),
+		Values:    values,
+		Id:        types.StringValue(property.ID),
+	}
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func stringList(values ...string) types.List {
+	elements := []attr.Value{}
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func TestCustomPropertyValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &CustomPropertyResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	testCases := map[string]struct {
+		valueType types.String
+		values    types.List
+		wantError bool
+	}{
+		"string":              {valueType: types.StringValue(foxglove.PropertyTypeString), values: types.ListNull(types.StringType)},
+		"enum":                {valueType: types.StringValue(foxglove.PropertyTypeEnum), values: stringList("alpha", "beta")},
+		"unknown values":      {valueType: types.StringValue(foxglove.PropertyTypeEnum), values: types.ListUnknown(types.StringType)},
+		"unknown value type":  {valueType: types.StringUnknown(), values: stringList("alpha")},
+		"invalid value type":  {valueType: types.StringValue("date"), values: types.ListNull(types.StringType), wantError: true},
+		"enum without values": {valueType: types.StringValue(foxglove.PropertyTypeEnum), values: types.ListNull(types.StringType), wantError: true},
+		"enum with no values": {valueType: types.StringValue(foxglove.PropertyTypeEnum), values: stringList(), wantError: true},
+		"number with values":  {valueType: types.StringValue(foxglove.PropertyTypeNumber), values: stringList("1"), wantError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := config.Set(ctx, &CustomPropertyResourceModel{
+				Key:       types.StringValue("fleet"),
+				Label:     types.StringValue("Fleet"),
+				ValueType: tc.valueType,
+				Values:    tc.values,
+				Id:        types.StringNull(),
+			}); diags.HasError() {
+				t.Fatalf("failed to build config: %v", diags)
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, resp)
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Errorf("expected error to be %v, got %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCustomPropertyModel(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		values      []string
+		priorValues types.List
+		want        types.List
+	}{
+		"no values":               {values: nil, priorValues: types.ListNull(types.StringType), want: types.ListNull(types.StringType)},
+		"values":                  {values: []string{"alpha", "beta"}, priorValues: types.ListNull(types.StringType), want: stringList("alpha", "beta")},
+		"values removed":          {values: nil, priorValues: stringList("alpha"), want: stringList()},
+		"empty values configured": {values: []string{}, priorValues: stringList(), want: stringList()},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model := customPropertyModel(ctx, &foxglove.CustomPropertyResponse{
+				ID:        "prop_1",
+				Key:       "fleet",
+				Label:     "Fleet",
+				ValueType: foxglove.PropertyTypeEnum,
+				Values:    tc.values,
+			}, CustomPropertyResourceModel{Values: tc.priorValues})
+
+			if !model.Values.Equal(tc.want) {
+				t.Errorf("expected values %s, got %s", tc.want, model.Values)
+			}
+			if model.Id.ValueString() != "prop_1" || model.Key.ValueString() != "fleet" {
+				t.Errorf("unexpected property %+v", model)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DeviceDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &DeviceDataSource{}
+
+func NewDeviceDataSource() datasource.DataSource {
+	return &DeviceDataSource{}
+}
+
+// DeviceDataSource defines the data source implementation.
+type DeviceDataSource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// DeviceDataSourceModel describes the data source data model.
+type DeviceDataSourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Properties types.Map    `tfsdk:"properties"`
+	OrgId      types.String `tfsdk:"org_id"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+func (d *DeviceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (d *DeviceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Device",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The identifier of the device. Either `id` or `name` must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the device. Either `id` or `name` must be set.",
+			},
+			"properties": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Custom properties of the device.",
+			},
+			"org_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The organization the device belongs to.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Creation time of the device.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last update of the device.",
+			},
+		},
+	}
+}
+
+func (d *DeviceDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data DeviceDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid device lookup",
+			"Exactly one of id or name must be set.")
+	}
+}
+
+func (d *DeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.foxgloveClient = foxgloveClient
+}
+
+func (d *DeviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeviceDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameOrId := data.Id.ValueString()
+	if data.Id.IsNull() {
+		nameOrId = data.Name.ValueString()
+	}
+
+	device, err := d.foxgloveClient.GetDevice(ctx, nameOrId)
+	if foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("device not found", fmt.Sprintf("No device with name or id %q exists.", nameOrId))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read device", err.Error())
+		return
+	}
+
+	if device.Properties == nil {
+		device.Properties = map[string]string{}
+	}
+	properties, diags := types.MapValueFrom(ctx, types.StringType, device.Properties)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &DeviceDataSourceModel{
+		Id:         types.StringValue(device.ID),
+		Name:       types.StringValue(device.Name),
+		Properties: properties,
+		OrgId:      types.StringValue(device.OrgID),
+		CreatedAt:  types.StringValue(device.CreatedAt),
+		UpdatedAt:  types.StringValue(device.UpdatedAt.Format(time.RFC3339)),
+	})...)
+}
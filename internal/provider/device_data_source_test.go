@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDeviceDataSourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	d := &DeviceDataSource{}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	testCases := map[string]struct {
+		id        types.String
+		name      types.String
+		wantError bool
+	}{
+		"id":      {id: types.StringValue("dev_1"), name: types.StringNull()},
+		"name":    {id: types.StringNull(), name: types.StringValue("robot")},
+		"neither": {id: types.StringNull(), name: types.StringNull(), wantError: true},
+		"both":    {id: types.StringValue("dev_1"), name: types.StringValue("robot"), wantError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := config.Set(ctx, &DeviceDataSourceModel{
+				Id:         tc.id,
+				Name:       tc.name,
+				Properties: types.MapNull(types.StringType),
+				OrgId:      types.StringNull(),
+				CreatedAt:  types.StringNull(),
+				UpdatedAt:  types.StringNull(),
+			}); diags.HasError() {
+				t.Fatalf("failed to build config: %v", diags)
+			}
+
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, resp)
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Errorf("expected error to be %v, got %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"terraform-provider-foxglove-cloud/internal/foxglove"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +20,7 @@ import (
 
 var _ resource.Resource = &DeviceResource{}
 var _ resource.ResourceWithImportState = &DeviceResource{}
+var _ resource.ResourceWithModifyPlan = &DeviceResource{}
 
 func NewDeviceResource() resource.Resource {
 	return &DeviceResource{}
@@ -30,8 +33,51 @@ type DeviceResource struct {
 
 // DeviceResourceModel describes the resource data model.
 type DeviceResourceModel struct {
-	Name types.String `tfsdk:"name"`
-	Id   types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Properties    types.Map    `tfsdk:"properties"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	Id            types.String `tfsdk:"id"`
+}
+
+func (d *DeviceResourceModel) PropertiesValue() map[string]string {
+	properties := map[string]string{}
+	for key, value := range d.Properties.Elements() {
+		properties[key] = value.(types.String).ValueString()
+	}
+	return properties
+}
+
+// propertiesUpdate returns the properties payload turning prior into planned.
+// Properties missing from planned are sent as null, which removes them from the device.
+func propertiesUpdate(prior map[string]string, planned map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{}
+	for key := range prior {
+		properties[key] = nil
+	}
+	for key, value := range planned {
+		properties[key] = value
+	}
+	return properties
+}
+
+// propertiesValue converts device properties into a Terraform map. A device
+// without properties keeps a null map if prior is null, so that omitting the
+// attribute does not produce a diff. Values keep their spelling in prior if
+// they are equivalent, such as "1.50" for a number property returned as 1.5.
+func propertiesValue(ctx context.Context, properties map[string]string, prior types.Map) (types.Map, diag.Diagnostics) {
+	if len(properties) == 0 && prior.IsNull() {
+		return types.MapNull(types.StringType), nil
+	}
+
+	values := map[string]string{}
+	priorValues := prior.Elements()
+	for key, value := range properties {
+		values[key] = value
+		if priorValue, ok := priorValues[key].(types.String); ok && equivalentPropertyValue(priorValue.ValueString(), value) {
+			values[key] = priorValue.ValueString()
+		}
+	}
+	return types.MapValueFrom(ctx, types.StringType, values)
 }
 
 func (r *DeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -47,6 +93,17 @@ func (r *DeviceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:            true,
 				PlanModifiers:       []planmodifier.String{},
 			},
+			"properties": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Custom properties of the device, such as hardware revision or site. Properties removed from the configuration are removed from the device.",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Take over a device with the same name if it already exists instead of failing. Defaults to `false`. Prefer `terraform import` so that only one workspace manages the device.",
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Opaque identifier",
@@ -58,6 +115,74 @@ func (r *DeviceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+// ModifyPlan validates the planned properties against the custom device
+// properties of the organization, so that invalid values fail during plan.
+func (r *DeviceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.foxgloveClient == nil {
+		// the device is being destroyed, or the provider is not configured yet
+		return
+	}
+
+	var properties, priorProperties types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("properties"), &properties)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("properties"), &priorProperties)...)
+	}
+
+	if resp.Diagnostics.HasError() || properties.IsUnknown() || len(properties.Elements()) == 0 || properties.Equal(priorProperties) {
+		return
+	}
+
+	definitions, err := r.propertyDefinitions(ctx)
+	if foxglove.IsUnauthorized(err) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("properties"), "Device properties not validated",
+			"The api key lacks the customProperties.list capability, so properties are only checked when they are applied.")
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list custom device properties", err.Error())
+		return
+	}
+
+	for key, value := range properties.Elements() {
+		if value.IsUnknown() {
+			continue
+		}
+		if _, ok := definitions[key]; !ok {
+			// the property may be defined by a foxglove_custom_property in the same plan
+			resp.Diagnostics.AddAttributeWarning(path.Root("properties").AtMapKey(key), "Unknown device property",
+				definitions.check(key, value.(types.String).ValueString()).Error()+". Applying fails unless the property is defined first.")
+			continue
+		}
+		if err := definitions.check(key, value.(types.String).ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("properties").AtMapKey(key), "Invalid device property", err.Error())
+		}
+	}
+}
+
+// propertyDefinitions fetches the custom device properties of the organization.
+func (r *DeviceResource) propertyDefinitions(ctx context.Context) (propertyDefinitions, error) {
+	properties, err := r.foxgloveClient.ListCustomProperties(ctx, foxglove.CustomPropertyResourceDevice)
+	if err != nil {
+		return nil, err
+	}
+	return newPropertyDefinitions(properties), nil
+}
+
+// typedProperties converts properties into the JSON types of the custom
+// device properties of the organization. Without access to the definitions,
+// all values are sent as strings.
+func (r *DeviceResource) typedProperties(ctx context.Context, properties map[string]string, diags *diag.Diagnostics) map[string]interface{} {
+	if len(properties) == 0 {
+		return map[string]interface{}{}
+	}
+	definitions, err := r.propertyDefinitions(ctx)
+	if err != nil && !foxglove.IsUnauthorized(err) {
+		diags.AddError("failed to list custom device properties", err.Error())
+	}
+	return definitions.typed(properties)
+}
+
 func (r *DeviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -88,25 +213,44 @@ func (r *DeviceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	existingDevice, err := r.foxgloveClient.GetDevice(data.Name.ValueString())
+	existingDevice, err := r.foxgloveClient.GetDevice(ctx, data.Name.ValueString())
 	if err == nil {
-		resp.State.Set(ctx, &DeviceResourceModel{
-			Id:   types.StringValue(existingDevice.ID),
-			Name: types.StringValue(existingDevice.Name),
-		})
+		if !data.AdoptExisting.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Device already exists",
+				fmt.Sprintf("A device named %q already exists with ID %s. To manage it with Terraform, import it with "+
+					"\"terraform import <resource address> %s\" or an import block, or set adopt_existing = true to take it over.",
+					existingDevice.Name, existingDevice.ID, existingDevice.ID))
+			return
+		}
+
+		r.adopt(ctx, existingDevice, &data, resp)
+		return
+	}
+	if !foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to look up device", err.Error())
+		return
+	}
+
+	properties := r.typedProperties(ctx, data.PropertiesValue(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	device, err := r.foxgloveClient.CreateDevice(foxglove.CreateDeviceRequest{
-		Name: data.Name.ValueString(),
+	device, err := r.foxgloveClient.CreateDevice(ctx, foxglove.CreateDeviceRequest{
+		Name:       data.Name.ValueString(),
+		Properties: properties,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create device", err.Error())
 		return
 	}
 
+	propertiesMap, diags := propertiesValue(ctx, device.Properties, data.Properties)
+	resp.Diagnostics.Append(diags...)
+
 	data.Id = types.StringValue(device.ID)
 	data.Name = types.StringValue(device.Name)
+	data.Properties = propertiesMap
 
 	tflog.Trace(ctx, "created a resource")
 
@@ -126,36 +270,57 @@ func (r *DeviceResource) Read(ctx context.Context, req resource.ReadRequest, res
 	var device *foxglove.GetDeviceResponse
 	var err error
 	if data.Id.IsUnknown() {
-		device, err = r.foxgloveClient.GetDevice(data.Name.ValueString())
+		device, err = r.foxgloveClient.GetDevice(ctx, data.Name.ValueString())
 	} else {
-		device, err = r.foxgloveClient.GetDevice(data.Id.ValueString())
+		device, err = r.foxgloveClient.GetDevice(ctx, data.Id.ValueString())
 	}
 
-	if err != nil {
-		// device not found, this is expected
+	if data.AdoptExisting.IsNull() {
+		// the device was imported
+		data.AdoptExisting = types.BoolValue(false)
+	}
+
+	if foxglove.IsNotFound(err) {
+		// the device was deleted outside of terraform
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read device", err.Error())
+		return
+	}
+
+	properties, diags := propertiesValue(ctx, device.Properties, data.Properties)
+	resp.Diagnostics.Append(diags...)
 
 	// The device exists, update the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &DeviceResourceModel{
-		Id:   types.StringValue(device.ID),
-		Name: types.StringValue(device.Name),
+		Id:            types.StringValue(device.ID),
+		Name:          types.StringValue(device.Name),
+		Properties:    properties,
+		AdoptExisting: data.AdoptExisting,
 	})...)
 }
 
 func (r *DeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data DeviceResourceModel
+	var data, state DeviceResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	properties := r.typedProperties(ctx, data.PropertiesValue(), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	device, err := r.foxgloveClient.UpdateDevice(data.Id.ValueString(), foxglove.UpdateDeviceRequest{
-		Name: data.Name.ValueString(),
+	device, err := r.foxgloveClient.UpdateDevice(ctx, data.Id.ValueString(), foxglove.UpdateDeviceRequest{
+		Name:       data.Name.ValueString(),
+		Properties: propertiesUpdate(state.PropertiesValue(), properties),
 	})
 
 	if err != nil {
@@ -164,10 +329,40 @@ func (r *DeviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &DeviceResourceModel{
-		Name: types.StringValue(device.Name),
-		Id:   types.StringValue(device.ID),
-	})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, updatedDeviceModel(ctx, device, data, &resp.Diagnostics))...)
+}
+
+// adopt takes over an existing device, aligning its properties with the plan.
+func (r *DeviceResource) adopt(ctx context.Context, existingDevice *foxglove.GetDeviceResponse, data *DeviceResourceModel, resp *resource.CreateResponse) {
+	properties := r.typedProperties(ctx, data.PropertiesValue(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device, err := r.foxgloveClient.UpdateDevice(ctx, existingDevice.ID, foxglove.UpdateDeviceRequest{
+		Properties: propertiesUpdate(existingDevice.Properties, properties),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to adopt existing device", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "adopted existing device", map[string]interface{}{"id": device.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, updatedDeviceModel(ctx, device, *data, &resp.Diagnostics))...)
+}
+
+// updatedDeviceModel builds the state of a device from an update response.
+func updatedDeviceModel(ctx context.Context, device *foxglove.UpdateDeviceResponse, data DeviceResourceModel, diags *diag.Diagnostics) *DeviceResourceModel {
+	properties, d := propertiesValue(ctx, device.Properties, data.Properties)
+	diags.Append(d...)
+
+	return &DeviceResourceModel{
+		Name:          types.StringValue(device.Name),
+		Properties:    properties,
+		AdoptExisting: data.AdoptExisting,
+		Id:            types.StringValue(device.ID),
+	}
 }
 
 func (r *DeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -180,8 +375,8 @@ func (r *DeviceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	_, err := r.foxgloveClient.DeleteDevice(data.Id.ValueString())
-	if err != nil {
+	_, err := r.foxgloveClient.DeleteDevice(ctx, data.Id.ValueString())
+	if err != nil && !foxglove.IsNotFound(err) {
 		resp.Diagnostics.AddError("failed to delete device", err.Error())
 		return
 	}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPropertiesUpdate(t *testing.T) {
+	testCases := map[string]struct {
+		prior   map[string]string
+		planned map[string]interface{}
+		want    map[string]interface{}
+	}{
+		"added":     {prior: map[string]string{}, planned: map[string]interface{}{"fleet": "alpha"}, want: map[string]interface{}{"fleet": "alpha"}},
+		"changed":   {prior: map[string]string{"fleet": "alpha"}, planned: map[string]interface{}{"fleet": "beta"}, want: map[string]interface{}{"fleet": "beta"}},
+		"removed":   {prior: map[string]string{"fleet": "alpha", "payload_kg": "12.5"}, planned: map[string]interface{}{"fleet": "alpha"}, want: map[string]interface{}{"fleet": "alpha", "payload_kg": nil}},
+		"all gone":  {prior: map[string]string{"fleet": "alpha"}, planned: map[string]interface{}{}, want: map[string]interface{}{"fleet": nil}},
+		"typed":     {prior: map[string]string{"payload_kg": "12.5"}, planned: map[string]interface{}{"payload_kg": 13.0}, want: map[string]interface{}{"payload_kg": 13.0}},
+		"no change": {prior: nil, planned: map[string]interface{}{}, want: map[string]interface{}{}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := propertiesUpdate(tc.prior, tc.planned); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPropertiesValue(t *testing.T) {
+	ctx := context.Background()
+	stringMap := func(values map[string]string) types.Map {
+		elements := map[string]attr.Value{}
+		for key, value := range values {
+			elements[key] = types.StringValue(value)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+
+	testCases := map[string]struct {
+		properties map[string]string
+		prior      types.Map
+		want       types.Map
+	}{
+		"null prior without properties":  {properties: nil, prior: types.MapNull(types.StringType), want: types.MapNull(types.StringType)},
+		"empty prior without properties": {properties: nil, prior: stringMap(map[string]string{}), want: stringMap(map[string]string{})},
+		"null prior with properties":     {properties: map[string]string{"fleet": "alpha"}, prior: types.MapNull(types.StringType), want: stringMap(map[string]string{"fleet": "alpha"})},
+		"equivalent number":              {properties: map[string]string{"payload_kg": "12.5"}, prior: stringMap(map[string]string{"payload_kg": "12.50"}), want: stringMap(map[string]string{"payload_kg": "12.50"})},
+		"changed number":                 {properties: map[string]string{"payload_kg": "13"}, prior: stringMap(map[string]string{"payload_kg": "12.50"}), want: stringMap(map[string]string{"payload_kg": "13"})},
+		"removed outside of terraform":   {properties: map[string]string{}, prior: stringMap(map[string]string{"fleet": "alpha"}), want: stringMap(map[string]string{})},
+		"added outside of terraform":     {properties: map[string]string{"fleet": "alpha", "site": "lab"}, prior: stringMap(map[string]string{"fleet": "alpha"}), want: stringMap(map[string]string{"fleet": "alpha", "site": "lab"})},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := propertiesValue(ctx, tc.properties, tc.prior)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDeviceCreateExisting(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		adoptExisting bool
+		wantError     bool
+	}{
+		"fails by default": {wantError: true},
+		"adopt existing":   {adoptExisting: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var update map[string]interface{}
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "GET" && r.URL.Path == "/devices/robot":
+					json.NewEncoder(w).Encode(foxglove.GetDeviceResponse{
+						ID:         "dev_1",
+						Name:       "robot",
+						Properties: foxglove.DeviceProperties{"site": "lab", "payload_kg": "10"},
+					})
+				case r.Method == "GET" && r.URL.Path == "/custom-properties":
+					json.NewEncoder(w).Encode([]foxglove.CustomPropertyResponse{
+						{Key: "payload_kg", ResourceType: foxglove.CustomPropertyResourceDevice, ValueType: "number"},
+						{Key: "site", ResourceType: foxglove.CustomPropertyResourceDevice, ValueType: "string"},
+					})
+				case r.Method == "PATCH" && r.URL.Path == "/devices/dev_1":
+					json.NewDecoder(r.Body).Decode(&update)
+					json.NewEncoder(w).Encode(foxglove.UpdateDeviceResponse{
+						ID:         "dev_1",
+						Name:       "robot",
+						Properties: foxglove.DeviceProperties{"payload_kg": "12.5"},
+					})
+				default:
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+					http.Error(w, "unexpected request", http.StatusNotFound)
+				}
+			})
+			r := &DeviceResource{foxgloveClient: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, &DeviceResourceModel{
+				Name:          types.StringValue("robot"),
+				Properties:    types.MapValueMust(types.StringType, map[string]attr.Value{"payload_kg": types.StringValue("12.50")}),
+				AdoptExisting: types.BoolValue(tc.adoptExisting),
+				Id:            types.StringUnknown(),
+			}); diags.HasError() {
+				t.Fatalf("failed to build plan: %v", diags)
+			}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Fatalf("expected error to be %v, got %v", tc.wantError, resp.Diagnostics)
+			}
+
+			if tc.wantError {
+				if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Device already exists" {
+					t.Errorf("unexpected error %q", summary)
+				}
+				if update != nil || !resp.State.Raw.IsNull() {
+					t.Errorf("expected the existing device to be left alone, got update %v", update)
+				}
+				return
+			}
+
+			// properties not in the plan are removed, planned ones are sent typed
+			if want := map[string]interface{}{"site": nil, "payload_kg": 12.5}; !reflect.DeepEqual(update["properties"], want) {
+				t.Errorf("expected properties update %v, got %v", want, update["properties"])
+			}
+			var state DeviceResourceModel
+			resp.State.Get(ctx, &state)
+			if state.Id.ValueString() != "dev_1" || state.Properties.Elements()["payload_kg"].(types.String).ValueString() != "12.50" {
+				t.Errorf("unexpected state %+v", state)
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DeviceTokenResource{}
+var _ resource.ResourceWithImportState = &DeviceTokenResource{}
+var _ resource.ResourceWithValidateConfig = &DeviceTokenResource{}
+
+func NewDeviceTokenResource() resource.Resource {
+	return &DeviceTokenResource{}
+}
+
+// DeviceTokenResource defines the resource implementation.
+type DeviceTokenResource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// DeviceTokenResourceModel describes the resource data model.
+type DeviceTokenResourceModel struct {
+	DeviceId       types.String `tfsdk:"device_id"`
+	Id             types.String `tfsdk:"id"`
+	Token          types.String `tfsdk:"token"`
+	PgpKey         types.String `tfsdk:"pgp_key"`
+	EncryptedToken types.String `tfsdk:"encrypted_token"`
+	KeyFingerprint types.String `tfsdk:"key_fingerprint"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (r *DeviceTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_token"
+}
+
+func (r *DeviceTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Device token",
+		Attributes: map[string]schema.Attribute{
+			"device_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the device the token authenticates. Changing it, for example because the device is replaced, creates a new token.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Opaque identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The device token. Empty if `pgp_key` is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pgp_key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: pgpKeyDescription("token", "encrypted_token"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"encrypted_token": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The device token encrypted with `pgp_key`, base64 encoded",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fingerprint of the PGP key used to encrypt the device token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the device can authenticate with the token. Set to `false` to disable a token without deleting it. Defaults to `true`.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Creation time of the token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DeviceTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DeviceTokenResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PgpKey.IsNull() && !data.PgpKey.IsUnknown() {
+		if _, err := readPGPKey(data.PgpKey.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("pgp_key"), "Invalid pgp_key", err.Error())
+		}
+	}
+}
+
+func (r *DeviceTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.foxgloveClient = foxgloveClient
+}
+
+func (r *DeviceTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeviceTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceToken, err := r.foxgloveClient.CreateDeviceToken(ctx, foxglove.CreateDeviceTokenRequest{
+		DeviceID: data.DeviceId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create device token", err.Error())
+		return
+	}
+
+	stored, ok := storeSecret(data.PgpKey, "device token", deviceToken.Token, func() error {
+		return r.foxgloveClient.DeleteDeviceToken(ctx, deviceToken.ID)
+	}, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Tokens are always created enabled
+	enabled := deviceToken.Enabled
+	if !data.Enabled.ValueBool() {
+		updatedToken, err := r.foxgloveClient.UpdateDeviceToken(ctx, deviceToken.ID, foxglove.UpdateDeviceTokenRequest{
+			Enabled: data.Enabled.ValueBoolPointer(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to disable device token", err.Error())
+		} else {
+			enabled = updatedToken.Enabled
+		}
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &DeviceTokenResourceModel{
+		DeviceId:       types.StringValue(deviceToken.DeviceID),
+		Id:             types.StringValue(deviceToken.ID),
+		Token:          stored.Secret,
+		PgpKey:         data.PgpKey,
+		EncryptedToken: stored.Encrypted,
+		KeyFingerprint: stored.KeyFingerprint,
+		Enabled:        types.BoolValue(enabled),
+		CreatedAt:      types.StringValue(deviceToken.CreatedAt),
+	})...)
+}
+
+func (r *DeviceTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeviceTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceToken, err := r.foxgloveClient.GetDeviceToken(ctx, data.Id.ValueString())
+	if foxglove.IsNotFound(err) {
+		// the token or its device was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read device token", err.Error())
+		return
+	}
+
+	// The token itself is only returned on creation
+	data.DeviceId = types.StringValue(deviceToken.DeviceID)
+	data.Id = types.StringValue(deviceToken.ID)
+	data.Enabled = types.BoolValue(deviceToken.Enabled)
+	data.CreatedAt = types.StringValue(deviceToken.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeviceTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceToken, err := r.foxgloveClient.UpdateDeviceToken(ctx, data.Id.ValueString(), foxglove.UpdateDeviceTokenRequest{
+		Enabled: data.Enabled.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update device token", err.Error())
+		return
+	}
+
+	data.Enabled = types.BoolValue(deviceToken.Enabled)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeviceTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DeviceTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.foxgloveClient.DeleteDeviceToken(ctx, data.Id.ValueString())
+	if err != nil && !foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete device token", err.Error())
+		return
+	}
+}
+
+func (r *DeviceTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
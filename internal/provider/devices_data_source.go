@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DevicesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &DevicesDataSource{}
+
+func NewDevicesDataSource() datasource.DataSource {
+	return &DevicesDataSource{}
+}
+
+// DevicesDataSource defines the data source implementation.
+type DevicesDataSource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// DevicesDataSourceModel describes the data source data model.
+type DevicesDataSourceModel struct {
+	Query      types.String            `tfsdk:"query"`
+	SortBy     types.String            `tfsdk:"sort_by"`
+	SortOrder  types.String            `tfsdk:"sort_order"`
+	Properties types.Map               `tfsdk:"properties"`
+	MaxResults types.Int64             `tfsdk:"max_results"`
+	Ids        []string                `tfsdk:"ids"`
+	Devices    []DeviceDataSourceModel `tfsdk:"devices"`
+}
+
+func (d *DevicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices"
+}
+
+func (d *DevicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Devices",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return devices matching this search query.",
+			},
+			"sort_by": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Field to sort the devices by, such as `name`.",
+			},
+			"sort_order": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Sort order, either `asc` or `desc`.",
+			},
+			"properties": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Only return devices having all of these property values.",
+			},
+			"max_results": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of devices to return. By default all matching devices are returned.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Identifiers of the matching devices.",
+			},
+			"devices": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching devices.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The identifier of the device.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the device.",
+						},
+						"properties": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "Custom properties of the device.",
+						},
+						"org_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The organization the device belongs to.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Creation time of the device.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Time of the last update of the device.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DevicesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data DevicesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SortOrder.IsNull() && !data.SortOrder.IsUnknown() {
+		if sortOrder := data.SortOrder.ValueString(); sortOrder != "asc" && sortOrder != "desc" {
+			resp.Diagnostics.AddAttributeError(path.Root("sort_order"), "Invalid sort_order",
+				fmt.Sprintf("sort_order must be either \"asc\" or \"desc\", got %q.", sortOrder))
+		}
+	}
+
+	if !data.MaxResults.IsNull() && !data.MaxResults.IsUnknown() && data.MaxResults.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_results"), "Invalid max_results",
+			"max_results must be greater than zero.")
+	}
+}
+
+func (d *DevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.foxgloveClient = foxgloveClient
+}
+
+func (d *DevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DevicesDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	propertyFilter := map[string]string{}
+	resp.Diagnostics.Append(data.Properties.ElementsAs(ctx, &propertyFilter, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := foxglove.DeviceFilter{
+		Query:     data.Query.ValueString(),
+		SortBy:    data.SortBy.ValueString(),
+		SortOrder: data.SortOrder.ValueString(),
+	}
+
+	data.Ids = []string{}
+	data.Devices = []DeviceDataSourceModel{}
+
+	// The property filter is applied here, so the item cap is checked after filtering
+	for device, err := range d.foxgloveClient.AllDevices(ctx, filter, foxglove.PageOptions{}) {
+		if err != nil {
+			resp.Diagnostics.AddError("failed to list devices", err.Error())
+			return
+		}
+
+		if !matchesProperties(device.Properties, propertyFilter) {
+			continue
+		}
+
+		if device.Properties == nil {
+			device.Properties = map[string]string{}
+		}
+		properties, diags := types.MapValueFrom(ctx, types.StringType, device.Properties)
+		resp.Diagnostics.Append(diags...)
+
+		data.Ids = append(data.Ids, device.ID)
+		data.Devices = append(data.Devices, DeviceDataSourceModel{
+			Id:         types.StringValue(device.ID),
+			Name:       types.StringValue(device.Name),
+			Properties: properties,
+			OrgId:      types.StringValue(device.OrgID),
+			CreatedAt:  types.StringValue(device.CreatedAt),
+			UpdatedAt:  types.StringValue(device.UpdatedAt.Format(time.RFC3339)),
+		})
+
+		if !data.MaxResults.IsNull() && int64(len(data.Devices)) >= data.MaxResults.ValueInt64() {
+			break
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matchesProperties reports whether properties contain all key/value pairs of
+// filter. Numbers match however they are spelled, since the API normalizes them.
+func matchesProperties(properties map[string]string, filter map[string]string) bool {
+	for key, value := range filter {
+		if actual, ok := properties[key]; !ok || !equivalentPropertyValue(actual, value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMatchesProperties(t *testing.T) {
+	properties := map[string]string{"fleet": "alpha", "payload_kg": "12.5"}
+
+	testCases := map[string]struct {
+		filter map[string]string
+		want   bool
+	}{
+		"no filter":                  {filter: map[string]string{}, want: true},
+		"equal value":                {filter: map[string]string{"fleet": "alpha"}, want: true},
+		"all values":                 {filter: map[string]string{"fleet": "alpha", "payload_kg": "12.5"}, want: true},
+		"differently spelled number": {filter: map[string]string{"payload_kg": "12.50"}, want: true},
+		"other value":                {filter: map[string]string{"fleet": "beta"}, want: false},
+		"other number":               {filter: map[string]string{"payload_kg": "12.6"}, want: false},
+		"missing key":                {filter: map[string]string{"region": "eu"}, want: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := matchesProperties(properties, tc.filter); got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDevicesDataSourceMaxResults(t *testing.T) {
+	ctx := context.Background()
+
+	// every second device is in the alpha fleet
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		devices := []foxglove.ListDeviceResponse{}
+		for i := offset; i < offset+limit && i < 250; i++ {
+			fleet := "alpha"
+			if i%2 == 1 {
+				fleet = "beta"
+			}
+			devices = append(devices, foxglove.ListDeviceResponse{
+				ID:         fmt.Sprintf("dev_%d", i),
+				Properties: foxglove.DeviceProperties{"fleet": fleet},
+			})
+		}
+		json.NewEncoder(w).Encode(devices)
+	})
+	d := &DevicesDataSource{foxgloveClient: client}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := config.Set(ctx, &DevicesDataSourceModel{
+		Query:      types.StringNull(),
+		SortBy:     types.StringNull(),
+		SortOrder:  types.StringNull(),
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{"fleet": types.StringValue("alpha")}),
+		MaxResults: types.Int64Value(60),
+	}); diags.HasError() {
+		t.Fatalf("failed to build config: %v", diags)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data DevicesDataSourceModel
+	resp.State.Get(ctx, &data)
+	// the cap applies to the filtered devices, which span more than one page
+	if len(data.Ids) != 60 || data.Ids[0] != "dev_0" || data.Ids[59] != "dev_118" {
+		t.Errorf("expected the first 60 alpha devices, got %d devices %v", len(data.Ids), data.Ids)
+	}
+}
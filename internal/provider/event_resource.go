@@ -0,0 +1,309 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &EventResource{}
+var _ resource.ResourceWithImportState = &EventResource{}
+var _ resource.ResourceWithValidateConfig = &EventResource{}
+
+func NewEventResource() resource.Resource {
+	return &EventResource{}
+}
+
+// EventResource defines the resource implementation.
+type EventResource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// EventResourceModel describes the resource data model.
+type EventResourceModel struct {
+	DeviceId   types.String `tfsdk:"device_id"`
+	DeviceName types.String `tfsdk:"device_name"`
+	Start      types.String `tfsdk:"start"`
+	End        types.String `tfsdk:"end"`
+	Metadata   types.Map    `tfsdk:"metadata"`
+	Id         types.String `tfsdk:"id"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+func (e *EventResourceModel) MetadataValue() map[string]string {
+	metadata := map[string]string{}
+	for key, value := range e.Metadata.Elements() {
+		metadata[key] = value.(types.String).ValueString()
+	}
+	return metadata
+}
+
+func (r *EventResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event"
+}
+
+func (r *EventResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Event",
+		Attributes: map[string]schema.Attribute{
+			"device_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The identifier of the device the event belongs to. Either `device_id` or `device_name` must be set. Changing it replaces the event.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the device the event belongs to. Either `device_id` or `device_name` must be set. Changing it replaces the event.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Start of the annotated time range as an RFC 3339 timestamp.",
+			},
+			"end": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "End of the annotated time range as an RFC 3339 timestamp. Equal to `start` for an instant.",
+			},
+			"metadata": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Key/value metadata of the event, such as the kind of incident.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Opaque identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Creation time of the event.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last update of the event.",
+			},
+		},
+	}
+}
+
+func (r *EventResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data EventResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DeviceId.IsUnknown() && !data.DeviceName.IsUnknown() && data.DeviceId.IsNull() == data.DeviceName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("device_id"), "Invalid device",
+			"Exactly one of device_id or device_name must be set.")
+	}
+
+	if data.Start.IsUnknown() || data.End.IsUnknown() {
+		return
+	}
+
+	start := parseTimestamp(&resp.Diagnostics, path.Root("start"), data.Start.ValueString())
+	end := parseTimestamp(&resp.Diagnostics, path.Root("end"), data.End.ValueString())
+	if !resp.Diagnostics.HasError() && end.Before(start) {
+		resp.Diagnostics.AddAttributeError(path.Root("end"), "Invalid end",
+			fmt.Sprintf("end %s must not be before start %s.", data.End.ValueString(), data.Start.ValueString()))
+	}
+}
+
+func (r *EventResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.foxgloveClient = foxgloveClient
+}
+
+func (r *EventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EventResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, err := r.foxgloveClient.CreateEvent(ctx, foxglove.CreateEventRequest{
+		DeviceID:   data.DeviceId.ValueString(),
+		DeviceName: data.DeviceName.ValueString(),
+		Start:      apiTimestamp(data.Start.ValueString()),
+		End:        apiTimestamp(data.End.ValueString()),
+		Metadata:   data.MetadataValue(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create event", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, eventModel(ctx, event, data, &resp.Diagnostics))...)
+}
+
+func (r *EventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EventResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, err := r.foxgloveClient.GetEvent(ctx, data.Id.ValueString())
+	if foxglove.IsNotFound(err) {
+		// the event was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read event", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, eventModel(ctx, event, data, &resp.Diagnostics))...)
+}
+
+func (r *EventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EventResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, err := r.foxgloveClient.UpdateEvent(ctx, data.Id.ValueString(), foxglove.UpdateEventRequest{
+		DeviceID:   data.DeviceId.ValueString(),
+		DeviceName: data.DeviceName.ValueString(),
+		Start:      apiTimestamp(data.Start.ValueString()),
+		End:        apiTimestamp(data.End.ValueString()),
+		Metadata:   data.MetadataValue(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update event", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, eventModel(ctx, event, data, &resp.Diagnostics))...)
+}
+
+func (r *EventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EventResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.foxgloveClient.DeleteEvent(ctx, data.Id.ValueString())
+	if err != nil && !foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete event", err.Error())
+		return
+	}
+}
+
+func (r *EventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// eventModel builds the state of an event. The device is tracked the way
+// prior references it, and timestamps keep their configured spelling as long
+// as they denote the same instant.
+func eventModel(ctx context.Context, event *foxglove.EventResponse, prior EventResourceModel, diags *diag.Diagnostics) *EventResourceModel {
+	deviceId, deviceName := event.DeviceID, ""
+	if event.Device != nil {
+		deviceId, deviceName = event.Device.ID, event.Device.Name
+	}
+
+	data := &EventResourceModel{
+		DeviceId:   types.StringNull(),
+		DeviceName: types.StringNull(),
+		Start:      timestampValue(event.Start, prior.Start),
+		End:        timestampValue(event.End, prior.End),
+		Id:         types.StringValue(event.ID),
+		CreatedAt:  types.StringValue(event.CreatedAt),
+		UpdatedAt:  types.StringValue(event.UpdatedAt),
+	}
+	switch {
+	case prior.DeviceName.IsNull():
+		data.DeviceId = types.StringValue(deviceId)
+	case deviceName != "":
+		data.DeviceName = types.StringValue(deviceName)
+	default:
+		data.DeviceName = prior.DeviceName
+	}
+
+	metadata, d := propertiesValue(ctx, event.Metadata, prior.Metadata)
+	diags.Append(d...)
+	data.Metadata = metadata
+
+	return data
+}
+
+// apiTimestamp normalizes a validated RFC 3339 timestamp to UTC.
+func apiTimestamp(value string) string {
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return timestamp.UTC().Format(time.RFC3339Nano)
+}
+
+// timestampValue returns prior if it denotes the same instant as the API
+// timestamp value, and value otherwise.
+func timestampValue(value string, prior types.String) types.String {
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return types.StringValue(value)
+	}
+	if priorTimestamp, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && priorTimestamp.Equal(timestamp) {
+		return prior
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimestampValue(t *testing.T) {
+	prior := types.StringValue("2024-06-01T14:00:00+02:00")
+
+	if value := timestampValue("2024-06-01T12:00:00.000Z", prior); !value.Equal(prior) {
+		t.Errorf("expected the configured spelling of the same instant to be kept, got %s", value)
+	}
+	if value := timestampValue("2024-06-01T13:00:00Z", prior); value.ValueString() != "2024-06-01T13:00:00Z" {
+		t.Errorf("expected a changed instant to be taken from the API, got %s", value)
+	}
+	if value := timestampValue("2024-06-01T12:00:00Z", types.StringNull()); value.ValueString() != "2024-06-01T12:00:00Z" {
+		t.Errorf("expected the API value after import, got %s", value)
+	}
+}
+
+func TestApiTimestamp(t *testing.T) {
+	if value := apiTimestamp("2024-06-01T14:00:00+02:00"); value != "2024-06-01T12:00:00Z" {
+		t.Errorf("expected a UTC timestamp, got %s", value)
+	}
+}
+
+func TestEventValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &EventResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	testCases := map[string]struct {
+		deviceId   types.String
+		deviceName types.String
+		start      types.String
+		end        types.String
+		wantError  bool
+	}{
+		"device id":              {deviceId: types.StringValue("dev_1"), deviceName: types.StringNull(), start: types.StringValue("2024-06-01T12:00:00Z"), end: types.StringValue("2024-06-01T13:00:00Z")},
+		"device name":            {deviceId: types.StringNull(), deviceName: types.StringValue("robot"), start: types.StringValue("2024-06-01T12:00:00Z"), end: types.StringValue("2024-06-01T13:00:00Z")},
+		"instant":                {deviceId: types.StringValue("dev_1"), deviceName: types.StringNull(), start: types.StringValue("2024-06-01T14:00:00+02:00"), end: types.StringValue("2024-06-01T12:00:00Z")},
+		"unknown device":         {deviceId: types.StringUnknown(), deviceName: types.StringNull(), start: types.StringValue("2024-06-01T12:00:00Z"), end: types.StringValue("2024-06-01T13:00:00Z")},
+		"unknown end":            {deviceId: types.StringValue("dev_1"), deviceName: types.StringNull(), start: types.StringValue("2024-06-01T12:00:00Z"), end: types.StringUnknown()},
+		"no device":              {deviceId: types.StringNull(), deviceName: types.StringNull(), start: types.StringValue("2024-06-01T12:00:00Z"), end: types.StringValue("2024-06-01T13:00:00Z"), wantError: true},
+		"both devices":           {deviceId: types.StringValue("dev_1"), deviceName: types.StringValue("robot"), start: types.StringValue("2024-06-01T12:00:00Z"), end: types.StringValue("2024-06-01T13:00:00Z"), wantError: true},
+		"end before start":       {deviceId: types.StringValue("dev_1"), deviceName: types.StringNull(), start: types.StringValue("2024-06-01T13:00:00Z"), end: types.StringValue("2024-06-01T12:00:00Z"), wantError: true},
+		"invalid start":          {deviceId: types.StringValue("dev_1"), deviceName: types.StringNull(), start: types.StringValue("yesterday"), end: types.StringValue("2024-06-01T12:00:00Z"), wantError: true},
+		"timestamp without zone": {deviceId: types.StringValue("dev_1"), deviceName: types.StringNull(), start: types.StringValue("2024-06-01T12:00:00"), end: types.StringValue("2024-06-01T13:00:00Z"), wantError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := config.Set(ctx, &EventResourceModel{
+				DeviceId:   tc.deviceId,
+				DeviceName: tc.deviceName,
+				Start:      tc.start,
+				End:        tc.end,
+				Metadata:   types.MapNull(types.StringType),
+				Id:         types.StringNull(),
+				CreatedAt:  types.StringNull(),
+				UpdatedAt:  types.StringNull(),
+			}); diags.HasError() {
+				t.Fatalf("failed to build config: %v", diags)
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, resp)
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Errorf("expected error to be %v, got %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestEventModel(t *testing.T) {
+	ctx := context.Background()
+	event := &foxglove.EventResponse{
+		ID:        "evt_1",
+		DeviceID:  "dev_1",
+		Device:    &foxglove.RecordingDevice{ID: "dev_1", Name: "robot"},
+		Start:     "2024-06-01T12:00:00Z",
+		End:       "2024-06-01T13:00:00Z",
+		Metadata:  map[string]string{"kind": "collision"},
+		CreatedAt: "2024-06-02T00:00:00Z",
+		UpdatedAt: "2024-06-03T00:00:00Z",
+	}
+
+	byId := EventResourceModel{
+		DeviceId:   types.StringValue("dev_1"),
+		DeviceName: types.StringNull(),
+		Start:      types.StringValue("2024-06-01T14:00:00+02:00"),
+		End:        types.StringValue("2024-06-01T13:00:00Z"),
+		Metadata:   types.MapValueMust(types.StringType, map[string]attr.Value{"kind": types.StringValue("collision")}),
+	}
+	var diags diag.Diagnostics
+	model := eventModel(ctx, event, byId, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if model.Id.ValueString() != "evt_1" || model.CreatedAt.ValueString() != event.CreatedAt || model.UpdatedAt.ValueString() != event.UpdatedAt {
+		t.Errorf("Unexpected event %+v", model)
+	}
+	if model.DeviceId.ValueString() != "dev_1" || !model.DeviceName.IsNull() {
+		t.Errorf("expected the device to be referenced by id, got %s and %s", model.DeviceId, model.DeviceName)
+	}
+	if !model.Start.Equal(byId.Start) || !model.Metadata.Equal(byId.Metadata) {
+		t.Errorf("expected the configured start and metadata to be kept, got %s and %s", model.Start, model.Metadata)
+	}
+
+	byName := EventResourceModel{
+		DeviceId:   types.StringNull(),
+		DeviceName: types.StringValue("robot"),
+		Metadata:   types.MapNull(types.StringType),
+	}
+	model = eventModel(ctx, event, byName, &diags)
+	if !model.DeviceId.IsNull() || model.DeviceName.ValueString() != "robot" {
+		t.Errorf("expected the device to be referenced by name, got %s and %s", model.DeviceId, model.DeviceName)
+	}
+
+	// events without the expanded device keep the configured name
+	event.Device = nil
+	model = eventModel(ctx, event, byName, &diags)
+	if model.DeviceName.ValueString() != "robot" {
+		t.Errorf("expected the prior device name to be kept, got %s", model.DeviceName)
+	}
+
+	// after import there is no prior state
+	model = eventModel(ctx, event, EventResourceModel{}, &diags)
+	if model.DeviceId.ValueString() != "dev_1" || model.Start.ValueString() != event.Start {
+		t.Errorf("expected the API values after import, got %+v", model)
+	}
+}
@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ExtensionResource{}
+var _ resource.ResourceWithImportState = &ExtensionResource{}
+var _ resource.ResourceWithModifyPlan = &ExtensionResource{}
+
+func NewExtensionResource() resource.Resource {
+	return &ExtensionResource{}
+}
+
+// ExtensionResource defines the resource implementation.
+type ExtensionResource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// ExtensionResourceModel describes the resource data model.
+type ExtensionResourceModel struct {
+	File        types.String `tfsdk:"file"`
+	Sha256      types.String `tfsdk:"sha256"`
+	Name        types.String `tfsdk:"name"`
+	Publisher   types.String `tfsdk:"publisher"`
+	Version     types.String `tfsdk:"version"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Id          types.String `tfsdk:"id"`
+}
+
+// extensionPackage is a .foxe extension package read from disk.
+type extensionPackage struct {
+	data     []byte
+	sha256   string
+	manifest *foxglove.ExtensionManifest
+}
+
+// readExtensionPackage reads the .foxe extension package at name.
+func readExtensionPackage(name string) (*extensionPackage, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := foxglove.ReadExtensionManifest(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	sum := sha256.Sum256(data)
+	return &extensionPackage{
+		data:     data,
+		sha256:   hex.EncodeToString(sum[:]),
+		manifest: manifest,
+	}, nil
+}
+
+// describe sets the package details of model from the package.
+func (p *extensionPackage) describe(model *ExtensionResourceModel) {
+	model.Sha256 = types.StringValue(p.sha256)
+	model.Name = types.StringValue(p.manifest.Name)
+	model.Publisher = types.StringValue(p.manifest.Publisher)
+	model.Version = types.StringValue(p.manifest.Version)
+	model.DisplayName = types.StringValue(p.manifest.DisplayName)
+	if p.manifest.DisplayName == "" {
+		model.DisplayName = model.Name
+	}
+}
+
+func (r *ExtensionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extension"
+}
+
+func (r *ExtensionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Extension",
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path to the `.foxe` extension package. The package is uploaded again whenever its content changes.",
+			},
+			"sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 checksum of the uploaded package, hex encoded.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the extension from the `package.json` of the package. Changing it replaces the extension.",
+			},
+			"publisher": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The publisher of the extension from the `package.json` of the package. Changing it replaces the extension.",
+			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The active version of the extension.",
+			},
+			"display_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The display name of the extension.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Opaque identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan reads the package, so that changes to its content or to the
+// active version of the extension show up in the plan.
+func (r *ExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// the extension is being destroyed
+		return
+	}
+
+	var plan, state ExtensionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() || plan.File.IsUnknown() {
+		return
+	}
+
+	pkg, err := readExtensionPackage(plan.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "Invalid extension package", err.Error())
+		return
+	}
+
+	pkg.describe(&plan)
+
+	if !req.State.Raw.IsNull() {
+		if !plan.Name.Equal(state.Name) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("name"))
+		}
+		if !plan.Publisher.Equal(state.Publisher) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("publisher"))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ExtensionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.foxgloveClient = foxgloveClient
+}
+
+func (r *ExtensionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExtensionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upload(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("failed to upload extension", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExtensionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExtensionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extension, err := r.foxgloveClient.GetExtension(ctx, data.Id.ValueString())
+	if foxglove.IsNotFound(err) {
+		// the extension was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read extension", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, extensionModel(extension, data))...)
+}
+
+func (r *ExtensionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExtensionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upload(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("failed to upload extension", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExtensionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExtensionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.foxgloveClient.DeleteExtension(ctx, data.Id.ValueString())
+	if err != nil && !foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete extension", err.Error())
+		return
+	}
+}
+
+func (r *ExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// upload uploads the package planned in data and fills in the details of the
+// package which were unknown during plan. It fails if the package changed
+// since it was planned.
+func (r *ExtensionResource) upload(ctx context.Context, data *ExtensionResourceModel) error {
+	pkg, err := readExtensionPackage(data.File.ValueString())
+	if err != nil {
+		return err
+	}
+	if !data.Sha256.IsUnknown() && pkg.sha256 != data.Sha256.ValueString() {
+		return fmt.Errorf("%s changed since the plan was made, plan again", data.File.ValueString())
+	}
+
+	extension, err := r.foxgloveClient.UploadExtension(ctx, pkg.data)
+	if err != nil {
+		return err
+	}
+
+	data.Id = types.StringValue(extension.ID)
+	if data.Sha256.IsUnknown() {
+		pkg.describe(data)
+	}
+	return nil
+}
+
+// extensionModel builds the state of an extension. The package file and its
+// checksum are those of prior, since the api only knows the active version.
+// Details missing from the response keep their prior value.
+func extensionModel(extension *foxglove.ExtensionResponse, prior ExtensionResourceModel) *ExtensionResourceModel {
+	model := prior
+	model.Id = types.StringValue(extension.ID)
+	for _, detail := range []struct {
+		value string
+		field *types.String
+	}{
+		{extension.Name, &model.Name},
+		{extension.Publisher, &model.Publisher},
+		{extension.ActiveVersion, &model.Version},
+		{extension.DisplayName, &model.DisplayName},
+	} {
+		if detail.value != "" {
+			*detail.field = types.StringValue(detail.value)
+		}
+	}
+	return &model
+}
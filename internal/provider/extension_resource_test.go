@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReadExtensionPackage(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "panel.foxe")
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive := zip.NewWriter(f)
+	w, _ := archive.Create("package.json")
+	w.Write([]byte(`{"name":"panel","publisher":"acme","version":"1.0.0"}`))
+	archive.Close()
+	f.Close()
+
+	pkg, err := readExtensionPackage(name)
+	if err != nil {
+		t.Fatalf("Failed to read package: %v", err)
+	}
+	if len(pkg.sha256) != 64 {
+		t.Errorf("Unexpected checksum %q", pkg.sha256)
+	}
+
+	var model ExtensionResourceModel
+	pkg.describe(&model)
+	if model.Version.ValueString() != "1.0.0" || model.DisplayName.ValueString() != "panel" {
+		t.Errorf("Unexpected package details %+v", model)
+	}
+
+	if _, err := readExtensionPackage(filepath.Join(t.TempDir(), "missing.foxe")); err == nil {
+		t.Errorf("Expected an error for a missing package")
+	}
+}
+
+func TestExtensionModel(t *testing.T) {
+	prior := ExtensionResourceModel{
+		File:        types.StringValue("panel.foxe"),
+		Sha256:      types.StringValue("abc"),
+		Name:        types.StringValue("panel"),
+		Publisher:   types.StringValue("acme"),
+		Version:     types.StringValue("1.0.0"),
+		DisplayName: types.StringValue("Panel"),
+	}
+
+	model := extensionModel(&foxglove.ExtensionResponse{ID: "ext_1", Name: "panel", ActiveVersion: "0.9.0"}, prior)
+	if model.Version.ValueString() != "0.9.0" {
+		t.Errorf("expected the active version, got %s", model.Version)
+	}
+	if !model.DisplayName.Equal(prior.DisplayName) || !model.Sha256.Equal(prior.Sha256) || !model.File.Equal(prior.File) {
+		t.Errorf("expected missing details to keep their prior value, got %+v", model)
+	}
+
+	// the name and display name are often the same
+	renamed := extensionModel(&foxglove.ExtensionResponse{ID: "ext_1", Name: "panel", DisplayName: "panel", ActiveVersion: "1.0.0"}, prior)
+	if renamed.Name.ValueString() != "panel" || renamed.DisplayName.ValueString() != "panel" {
+		t.Errorf("expected both name and display name from the response, got %+v", renamed)
+	}
+}
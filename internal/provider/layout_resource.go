@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &LayoutResource{}
+var _ resource.ResourceWithImportState = &LayoutResource{}
+var _ resource.ResourceWithValidateConfig = &LayoutResource{}
+
+// layoutPermissions are the permission scopes a layout can have.
+var layoutPermissions = []string{
+	foxglove.LayoutPermissionCreatorWrite,
+	foxglove.LayoutPermissionOrgRead,
+	foxglove.LayoutPermissionOrgWrite,
+}
+
+func NewLayoutResource() resource.Resource {
+	return &LayoutResource{}
+}
+
+// LayoutResource defines the resource implementation.
+type LayoutResource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// LayoutResourceModel describes the resource data model.
+type LayoutResourceModel struct {
+	Name       types.String         `tfsdk:"name"`
+	Data       jsontypes.Normalized `tfsdk:"data"`
+	Permission types.String         `tfsdk:"permission"`
+	Id         types.String         `tfsdk:"id"`
+	CreatedAt  types.String         `tfsdk:"created_at"`
+	UpdatedAt  types.String         `tfsdk:"updated_at"`
+}
+
+func (r *LayoutResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_layout"
+}
+
+func (r *LayoutResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Layout",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the layout.",
+			},
+			"data": schema.StringAttribute{
+				CustomType:          jsontypes.NormalizedType{},
+				Required:            true,
+				MarkdownDescription: "The layout as a JSON object, for example from `jsonencode` or `file`. Key order and whitespace do not cause changes.",
+			},
+			"permission": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(foxglove.LayoutPermissionOrgRead),
+				MarkdownDescription: "Who can see and edit the layout: `CREATOR_WRITE` for a personal layout, `ORG_READ` for a layout the organization can see but only Terraform changes, or `ORG_WRITE` for a layout the organization can edit. Defaults to `ORG_READ`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Opaque identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Creation time of the layout.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last update of the layout.",
+			},
+		},
+	}
+}
+
+func (r *LayoutResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data LayoutResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Permission.IsNull() && !data.Permission.IsUnknown() && !slices.Contains(layoutPermissions, data.Permission.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("permission"), "Invalid permission",
+			fmt.Sprintf("permission must be one of %s, got %q.", strings.Join(layoutPermissions, ", "), data.Permission.ValueString()))
+	}
+
+	// Invalid JSON is reported by the attribute type
+	if !data.Data.IsNull() && !data.Data.IsUnknown() {
+		var layout interface{}
+		if err := json.Unmarshal([]byte(data.Data.ValueString()), &layout); err == nil {
+			if _, ok := layout.(map[string]interface{}); !ok {
+				resp.Diagnostics.AddAttributeError(path.Root("data"), "Invalid layout data", "data must be a JSON object.")
+			}
+		}
+	}
+}
+
+func (r *LayoutResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.foxgloveClient = foxgloveClient
+}
+
+func (r *LayoutResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LayoutResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	layout, err := r.foxgloveClient.CreateLayout(ctx, foxglove.CreateLayoutRequest{
+		Name:       data.Name.ValueString(),
+		Permission: data.Permission.ValueString(),
+		Data:       json.RawMessage(data.Data.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create layout", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, layoutModel(layout, data))...)
+}
+
+func (r *LayoutResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LayoutResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	layout, err := r.foxgloveClient.GetLayout(ctx, data.Id.ValueString())
+	if foxglove.IsNotFound(err) {
+		// the layout was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read layout", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, layoutModel(layout, data))...)
+}
+
+func (r *LayoutResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LayoutResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	layout, err := r.foxgloveClient.UpdateLayout(ctx, data.Id.ValueString(), foxglove.UpdateLayoutRequest{
+		Name:       data.Name.ValueString(),
+		Permission: data.Permission.ValueString(),
+		Data:       json.RawMessage(data.Data.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update layout", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, layoutModel(layout, data))...)
+}
+
+func (r *LayoutResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LayoutResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.foxgloveClient.DeleteLayout(ctx, data.Id.ValueString())
+	if err != nil && !foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete layout", err.Error())
+		return
+	}
+}
+
+func (r *LayoutResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// layoutModel builds the state of a layout. Responses without layout data
+// keep the data of prior. Data which only differs from prior in key order or
+// whitespace keeps the prior spelling through semantic equality.
+func layoutModel(layout *foxglove.LayoutResponse, prior LayoutResourceModel) *LayoutResourceModel {
+	data := prior.Data
+	if len(layout.Data) > 0 && string(layout.Data) != "null" {
+		data = jsontypes.NewNormalizedValue(string(layout.Data))
+	}
+
+	return &LayoutResourceModel{
+		Name:       types.StringValue(layout.Name),
+		Data:       data,
+		Permission: types.StringValue(layout.Permission),
+		Id:         types.StringValue(layout.ID),
+		CreatedAt:  types.StringValue(layout.CreatedAt),
+		UpdatedAt:  types.StringValue(layout.UpdatedAt),
+	}
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestLayoutModel(t *testing.T) {
+	prior := LayoutResourceModel{Data: jsontypes.NewNormalizedValue(`{"b": 1, "a": 2}`)}
+
+	withoutData := layoutModel(&foxglove.LayoutResponse{ID: "lay_1"}, prior)
+	if !withoutData.Data.Equal(prior.Data) {
+		t.Errorf("expected the prior data to be kept, got %s", withoutData.Data)
+	}
+
+	reordered := layoutModel(&foxglove.LayoutResponse{ID: "lay_1", Data: []byte(`{"a":2,"b":1}`)}, prior)
+	equal, diags := prior.Data.StringSemanticEquals(context.Background(), reordered.Data)
+	if diags.HasError() || !equal {
+		t.Errorf("expected reordered data to be semantically equal, got %s", reordered.Data)
+	}
+}
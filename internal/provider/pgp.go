@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// pgpKeyDescription documents the pgp_key attribute of resources exposing a
+// secret in the attribute secret, which is encrypted into encrypted.
+func pgpKeyDescription(secret string, encrypted string) string {
+	return "PGP public key, either ASCII armored or base64 encoded, used to encrypt the secret. " +
+		"If set, the secret is only stored encrypted in `" + encrypted + "` and `" + secret + "` is left empty. " +
+		"Decrypt it with `terraform output -raw <output> | base64 --decode | gpg --decrypt`."
+}
+
+// readPGPKey parses an ASCII armored or base64 encoded binary public key.
+func readPGPKey(pgpKey string) (*openpgp.Entity, error) {
+	pgpKey = strings.TrimSpace(pgpKey)
+
+	var entities openpgp.EntityList
+	var err error
+	if strings.HasPrefix(pgpKey, "-----BEGIN") {
+		entities, err = openpgp.ReadArmoredKeyRing(strings.NewReader(pgpKey))
+	} else {
+		var keyBytes []byte
+		keyBytes, err = base64.StdEncoding.DecodeString(pgpKey)
+		if err != nil {
+			return nil, fmt.Errorf("pgp key is neither ASCII armored nor base64 encoded: %w", err)
+		}
+		entities, err = openpgp.ReadKeyRing(bytes.NewReader(keyBytes))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pgp key: %w", err)
+	}
+	if len(entities) != 1 {
+		return nil, fmt.Errorf("expected exactly one pgp key, got %d", len(entities))
+	}
+	return entities[0], nil
+}
+
+// encryptSecret encrypts secret for pgpKey. It returns the base64 encoded
+// binary message and the fingerprint of the key.
+func encryptSecret(pgpKey string, secret string) (string, string, error) {
+	entity, err := readPGPKey(pgpKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	var encrypted bytes.Buffer
+	writer, err := openpgp.Encrypt(&encrypted, []*openpgp.Entity{entity}, nil, nil, &packet.Config{})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	if _, err := writer.Write([]byte(secret)); err != nil {
+		return "", "", fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", "", fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(encrypted.Bytes()), hex.EncodeToString(entity.PrimaryKey.Fingerprint), nil
+}
+
+// storedSecret is a newly created secret as it is written to the state.
+type storedSecret struct {
+	// Secret is the secret in clear text, or empty if it is encrypted.
+	Secret         types.String
+	Encrypted      types.String
+	KeyFingerprint types.String
+}
+
+// storeSecret encrypts the secret named name for pgpKey if one is set. If
+// encryption fails, the secret is deleted with deleteSecret and false is
+// returned, so that it is never stored in clear text when encryption was
+// requested.
+func storeSecret(pgpKey types.String, name string, secret string, deleteSecret func() error, diags *diag.Diagnostics) (storedSecret, bool) {
+	if pgpKey.IsNull() {
+		return storedSecret{
+			Secret:         types.StringValue(secret),
+			Encrypted:      types.StringNull(),
+			KeyFingerprint: types.StringNull(),
+		}, true
+	}
+
+	encrypted, fingerprint, err := encryptSecret(pgpKey.ValueString(), secret)
+	if err != nil {
+		if err := deleteSecret(); err != nil {
+			diags.AddError("failed to delete "+name+" after encryption error", err.Error())
+		}
+		diags.AddAttributeError(path.Root("pgp_key"), "failed to encrypt "+name, err.Error())
+		return storedSecret{}, false
+	}
+
+	return storedSecret{
+		Secret:         types.StringValue(""),
+		Encrypted:      types.StringValue(encrypted),
+		KeyFingerprint: types.StringValue(fingerprint),
+	}, true
+}
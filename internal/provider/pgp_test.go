@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEncryptSecret(t *testing.T) {
+	entity, err := openpgp.NewEntity("terraform", "", "terraform@example.com", nil)
+	if err != nil {
+		t.Fatalf("Failed to create pgp key: %v", err)
+	}
+
+	var armored bytes.Buffer
+	writer, _ := armor.Encode(&armored, openpgp.PublicKeyType, nil)
+	entity.Serialize(writer)
+	writer.Close()
+
+	var binary bytes.Buffer
+	entity.Serialize(&binary)
+
+	for name, pgpKey := range map[string]string{
+		"armored": armored.String(),
+		"base64":  base64.StdEncoding.EncodeToString(binary.Bytes()),
+	} {
+		encrypted, fingerprint, err := encryptSecret(pgpKey, "fox_sk_secret")
+		if err != nil {
+			t.Fatalf("%s: failed to encrypt secret: %v", name, err)
+		}
+		if fingerprint != hex.EncodeToString(entity.PrimaryKey.Fingerprint) {
+			t.Errorf("%s: unexpected fingerprint %s", name, fingerprint)
+		}
+
+		message, _ := base64.StdEncoding.DecodeString(encrypted)
+		details, err := openpgp.ReadMessage(bytes.NewReader(message), openpgp.EntityList{entity}, nil, nil)
+		if err != nil {
+			t.Fatalf("%s: failed to decrypt secret: %v", name, err)
+		}
+		decrypted, _ := io.ReadAll(details.UnverifiedBody)
+		if string(decrypted) != "fox_sk_secret" {
+			t.Errorf("%s: expected the decrypted secret, got %q", name, decrypted)
+		}
+	}
+}
+
+func TestEncryptSecretInvalidKey(t *testing.T) {
+	if _, _, err := encryptSecret("not a key", "fox_sk_secret"); err == nil || !strings.Contains(err.Error(), "base64") {
+		t.Fatalf("Expected an error about the key encoding, got: %v", err)
+	}
+}
+
+func TestStoreSecret(t *testing.T) {
+	deleted := false
+	deleteSecret := func() error {
+		deleted = true
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	stored, ok := storeSecret(types.StringNull(), "site token", "fox_st_secret", deleteSecret, &diags)
+	if !ok || stored.Secret.ValueString() != "fox_st_secret" || !stored.Encrypted.IsNull() || deleted {
+		t.Errorf("expected the secret to be stored in clear text without pgp key, got %+v", stored)
+	}
+
+	_, ok = storeSecret(types.StringValue("not a key"), "site token", "fox_st_secret", deleteSecret, &diags)
+	if ok || !deleted || !diags.HasError() {
+		t.Errorf("expected the secret to be deleted when encryption fails, got ok=%v deleted=%v", ok, deleted)
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+)
+
+// propertyValueTypes are the value types a custom property can have.
+var propertyValueTypes = []string{
+	foxglove.PropertyTypeString,
+	foxglove.PropertyTypeNumber,
+	foxglove.PropertyTypeBoolean,
+	foxglove.PropertyTypeEnum,
+}
+
+// propertyDefinitions indexes custom property definitions by key.
+type propertyDefinitions map[string]foxglove.CustomPropertyResponse
+
+func newPropertyDefinitions(properties []foxglove.CustomPropertyResponse) propertyDefinitions {
+	definitions := propertyDefinitions{}
+	for _, property := range properties {
+		definitions[property.Key] = property
+	}
+	return definitions
+}
+
+// check reports why value is not valid for the property key, or nil.
+func (d propertyDefinitions) check(key string, value string) error {
+	property, ok := d[key]
+	if !ok {
+		keys := make([]string, 0, len(d))
+		for key := range d {
+			keys = append(keys, "`"+key+"`")
+		}
+		sort.Strings(keys)
+		if len(keys) == 0 {
+			return fmt.Errorf("the organization defines no custom device properties, define %q with a foxglove_custom_property resource", key)
+		}
+		return fmt.Errorf("%q is not a custom device property of the organization, expected one of %s", key, strings.Join(keys, ", "))
+	}
+
+	switch property.ValueType {
+	case foxglove.PropertyTypeNumber:
+		if !isJSONNumber(value) {
+			return fmt.Errorf("property %q is a number, got %q", key, value)
+		}
+	case foxglove.PropertyTypeBoolean:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("property %q is a boolean, expected \"true\" or \"false\", got %q", key, value)
+		}
+	case foxglove.PropertyTypeEnum:
+		if !slices.Contains(property.Values, value) {
+			return fmt.Errorf("property %q must be one of %s, got %q", key, strings.Join(property.Values, ", "), value)
+		}
+	}
+	return nil
+}
+
+// typed converts property values into the JSON types of their definitions.
+// Values without a definition, or which do not parse, are sent as strings.
+func (d propertyDefinitions) typed(properties map[string]string) map[string]interface{} {
+	typed := map[string]interface{}{}
+	for key, value := range properties {
+		typed[key] = value
+		switch d[key].ValueType {
+		case foxglove.PropertyTypeNumber:
+			if isJSONNumber(value) {
+				typed[key] = json.Number(value)
+			}
+		case foxglove.PropertyTypeBoolean:
+			if boolean, err := strconv.ParseBool(value); err == nil {
+				typed[key] = boolean
+			}
+		}
+	}
+	return typed
+}
+
+// isJSONNumber reports whether value is a number in JSON syntax, which
+// excludes spellings strconv.ParseFloat accepts, such as "Inf", "+5", ".5",
+// "0x10" or "1_000".
+func isJSONNumber(value string) bool {
+	if value == "" || strings.TrimSpace(value) != value || !json.Valid([]byte(value)) {
+		return false
+	}
+	return value[0] == '-' || value[0] >= '0' && value[0] <= '9'
+}
+
+// equivalentPropertyValue reports whether two property values are equal,
+// treating different spellings of the same number or boolean as equal.
+func equivalentPropertyValue(a string, b string) bool {
+	if a == b {
+		return true
+	}
+	if x, err := strconv.ParseFloat(a, 64); err == nil {
+		y, err := strconv.ParseFloat(b, 64)
+		return err == nil && x == y
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+)
+
+func testPropertyDefinitions() propertyDefinitions {
+	return newPropertyDefinitions([]foxglove.CustomPropertyResponse{
+		{Key: "site", ValueType: foxglove.PropertyTypeString},
+		{Key: "payload_kg", ValueType: foxglove.PropertyTypeNumber},
+		{Key: "lidar", ValueType: foxglove.PropertyTypeBoolean},
+		{Key: "stage", ValueType: foxglove.PropertyTypeEnum, Values: []string{"dev", "prod"}},
+	})
+}
+
+func TestPropertyDefinitionsCheck(t *testing.T) {
+	definitions := testPropertyDefinitions()
+
+	valid := map[string]string{"site": "munich", "payload_kg": "12.5", "lidar": "true", "stage": "prod"}
+	for key, value := range valid {
+		if err := definitions.check(key, value); err != nil {
+			t.Errorf("expected %s=%q to be valid, got %v", key, value, err)
+		}
+	}
+
+	invalid := map[string]string{"payload_kg": "heavy", "lidar": "yes", "stage": "staging", "color": "red"}
+	for key, value := range invalid {
+		if err := definitions.check(key, value); err == nil {
+			t.Errorf("expected %s=%q to be invalid", key, value)
+		}
+	}
+
+	for _, value := range []string{"-3", "0", "1e3", "2.5E-2"} {
+		if err := definitions.check("payload_kg", value); err != nil {
+			t.Errorf("expected number %q to be valid, got %v", value, err)
+		}
+	}
+	// strconv.ParseFloat accepts these, but they are not JSON numbers
+	for _, value := range []string{"Inf", "NaN", "+5", ".5", "0x10", "1_000", "01", " 5", "5 ", `"5"`, "true"} {
+		if err := definitions.check("payload_kg", value); err == nil {
+			t.Errorf("expected number %q to be invalid", value)
+		}
+		if _, err := json.Marshal(definitions.typed(map[string]string{"payload_kg": value})); err != nil {
+			t.Errorf("expected %q to be sent as a string, got %v", value, err)
+		}
+	}
+}
+
+func TestPropertyDefinitionsTyped(t *testing.T) {
+	typed := testPropertyDefinitions().typed(map[string]string{
+		"site":       "munich",
+		"payload_kg": "12.5",
+		"lidar":      "false",
+		"undefined":  "42",
+	})
+
+	body, err := json.Marshal(typed)
+	if err != nil {
+		t.Fatalf("failed to marshal properties: %v", err)
+	}
+	expected := `{"lidar":false,"payload_kg":12.5,"site":"munich","undefined":"42"}`
+	if string(body) != expected {
+		t.Errorf("expected %s, got %s", expected, body)
+	}
+}
+
+func TestEquivalentPropertyValue(t *testing.T) {
+	if !equivalentPropertyValue("1.50", "1.5") {
+		t.Error("expected different spellings of a number to be equivalent")
+	}
+	if equivalentPropertyValue("1.5", "2") || equivalentPropertyValue("munich", "berlin") {
+		t.Error("expected different values not to be equivalent")
+	}
+}
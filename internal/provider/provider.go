@@ -4,11 +4,17 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
 	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,13 +23,22 @@ import (
 
 var _ provider.Provider = &FoxgloveProvider{}
 var _ provider.ProviderWithFunctions = &FoxgloveProvider{}
+var _ provider.ProviderWithEphemeralResources = &FoxgloveProvider{}
 
 type FoxgloveProvider struct {
 	version string
 }
 
 type FoxgloveProviderModel struct {
-	ApiKey types.String `tfsdk:"api_key"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	UserAgentSuffix    types.String `tfsdk:"user_agent_suffix"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait       types.String `tfsdk:"retry_max_wait"`
 }
 
 func (p *FoxgloveProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -38,6 +53,38 @@ func (p *FoxgloveProvider) Schema(ctx context.Context, req provider.SchemaReques
 				MarkdownDescription: "Foxglove API Key. Can also be set via environment variable FOXGLOVE_API_KEY",
 				Optional:            true,
 			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the Foxglove API. Defaults to `https://api.foxglove.dev/v1`. Can also be set via environment variable FOXGLOVE_ENDPOINT",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout of a single API request as a duration like `1m`, including reading its response. Uploads and downloads of recording files are not limited by it. Unlimited by default. Can also be set via environment variable FOXGLOVE_REQUEST_TIMEOUT",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable TLS certificate verification. Only use this against test endpoints. Can also be set via environment variable FOXGLOVE_INSECURE_SKIP_VERIFY",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM file with CA certificates trusted in addition to the system pool. Can also be set via environment variable FOXGLOVE_CA_CERT_FILE",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy used to reach the API. Defaults to the proxy from the HTTP_PROXY and HTTPS_PROXY environment variables. Can also be set via environment variable FOXGLOVE_PROXY_URL",
+				Optional:            true,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				MarkdownDescription: "Text appended to the User-Agent header sent to the API. Can also be set via environment variable FOXGLOVE_USER_AGENT_SUFFIX",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Number of times a request failing with a transient error (429 or 5xx) is retried. Defaults to 4. Can also be set via environment variable FOXGLOVE_MAX_RETRIES",
+				Optional:            true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: "Maximum delay between two retries as a positive duration like `30s`. Requests asking for a longer delay via `Retry-After` fail instead. Defaults to `30s`. Can also be set via environment variable FOXGLOVE_RETRY_MAX_WAIT",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -51,11 +98,7 @@ func (p *FoxgloveProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	apiKey := os.Getenv("FOXGLOVE_API_KEY")
-
-	if !data.ApiKey.IsNull() {
-		apiKey = data.ApiKey.ValueString()
-	}
+	apiKey := stringValueOrEnv(data.ApiKey, "FOXGLOVE_API_KEY")
 
 	if apiKey == "" {
 		resp.Diagnostics.AddError("Foxglove api key missing",
@@ -64,31 +107,133 @@ func (p *FoxgloveProvider) Configure(ctx context.Context, req provider.Configure
 				"If either is already set, ensure the value is not empty.")
 	}
 
+	config := foxglove.Config{
+		APIKey:     apiKey,
+		BaseURL:    stringValueOrEnv(data.Endpoint, "FOXGLOVE_ENDPOINT"),
+		CACertFile: stringValueOrEnv(data.CACertFile, "FOXGLOVE_CA_CERT_FILE"),
+		ProxyURL:   stringValueOrEnv(data.ProxyURL, "FOXGLOVE_PROXY_URL"),
+		UserAgent:  "terraform-provider-foxglove-cloud/" + p.version,
+	}
+
+	if suffix := stringValueOrEnv(data.UserAgentSuffix, "FOXGLOVE_USER_AGENT_SUFFIX"); suffix != "" {
+		config.UserAgent += " " + suffix
+	}
+
+	config.Timeout = parseDuration(&resp.Diagnostics, path.Root("request_timeout"),
+		stringValueOrEnv(data.RequestTimeout, "FOXGLOVE_REQUEST_TIMEOUT"), 0)
+
+	insecureSkipVerify := os.Getenv("FOXGLOVE_INSECURE_SKIP_VERIFY")
+	if !data.InsecureSkipVerify.IsNull() {
+		insecureSkipVerify = strconv.FormatBool(data.InsecureSkipVerify.ValueBool())
+	}
+	if insecureSkipVerify != "" {
+		value, err := strconv.ParseBool(insecureSkipVerify)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("insecure_skip_verify"), "Invalid insecure_skip_verify",
+				fmt.Sprintf("insecure_skip_verify must be a boolean, got %q.", insecureSkipVerify))
+		}
+		config.InsecureSkipVerify = value
+	}
+
+	retryPolicy := foxglove.DefaultRetryPolicy()
+
+	maxRetries := os.Getenv("FOXGLOVE_MAX_RETRIES")
+	if !data.MaxRetries.IsNull() {
+		maxRetries = strconv.FormatInt(data.MaxRetries.ValueInt64(), 10)
+	}
+	if maxRetries != "" {
+		value, err := strconv.Atoi(maxRetries)
+		if err != nil || value < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("max_retries"), "Invalid max_retries",
+				fmt.Sprintf("max_retries must be a non-negative integer, got %q.", maxRetries))
+		}
+		retryPolicy.MaxRetries = value
+	}
+
+	retryMaxWait := stringValueOrEnv(data.RetryMaxWait, "FOXGLOVE_RETRY_MAX_WAIT")
+	retryPolicy.MaxWait = parseDuration(&resp.Diagnostics, path.Root("retry_max_wait"), retryMaxWait, retryPolicy.MaxWait)
+	if retryPolicy.MaxWait == 0 {
+		// the client treats zero as no cap, which would honor any Retry-After
+		resp.Diagnostics.AddAttributeError(path.Root("retry_max_wait"), "Invalid retry_max_wait",
+			fmt.Sprintf("retry_max_wait must be a positive duration, got %q.", retryMaxWait))
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	foxgloveClient := foxglove.NewClient(apiKey)
+	foxgloveClient, err := foxglove.NewClientWithConfig(config)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Foxglove client configuration", err.Error())
+		return
+	}
+	foxgloveClient.RetryPolicy = retryPolicy
 
 	resp.DataSourceData = foxgloveClient
 	resp.ResourceData = foxgloveClient
+	resp.EphemeralResourceData = foxgloveClient
 }
 
 func (p *FoxgloveProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewDeviceResource,
+		NewDeviceTokenResource,
+		NewCustomPropertyResource,
+		NewLayoutResource,
+		NewExtensionResource,
+		NewRecordingImportResource,
 		NewApikeyResource,
+		NewApikeyRotationResource,
+		NewEventResource,
+		NewSiteResource,
+		NewSiteTokenResource,
+	}
+}
+
+func (p *FoxgloveProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewApikeyEphemeralResource,
 	}
 }
 
 func (p *FoxgloveProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewDeviceDataSource,
+		NewDevicesDataSource,
+		NewApikeysDataSource,
+		NewRecordingDataSource,
+		NewRecordingsDataSource,
+		NewStreamLinkDataSource,
+	}
 }
 
 func (p *FoxgloveProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{}
 }
 
+// stringValueOrEnv returns the configured value, falling back to the environment variable.
+func stringValueOrEnv(value types.String, envVar string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
+}
+
+// parseDuration parses a non-negative duration such as "30s" and reports an
+// attribute error if it is invalid. Empty values yield the default.
+func parseDuration(diags *diag.Diagnostics, attributePath path.Path, value string, defaultValue time.Duration) time.Duration {
+	if value == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(attributePath, "Invalid duration",
+			fmt.Sprintf("Expected a non-negative duration such as \"30s\", got %q.", value))
+		return defaultValue
+	}
+	return duration
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &FoxgloveProvider{
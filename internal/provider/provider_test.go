@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestSchemas validates the schema of the provider and of everything it registers.
+func TestSchemas(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")().(*FoxgloveProvider)
+
+	providerSchema := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, providerSchema)
+	if diags := providerSchema.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Errorf("provider: %v", diags)
+	}
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		metadata := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "foxglove"}, metadata)
+		schema := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schema)
+		if diags := schema.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Errorf("resource %s: %v", metadata.TypeName, diags)
+		}
+	}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+		metadata := &datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "foxglove"}, metadata)
+		schema := &datasource.SchemaResponse{}
+		d.Schema(ctx, datasource.SchemaRequest{}, schema)
+		if diags := schema.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Errorf("data source %s: %v", metadata.TypeName, diags)
+		}
+	}
+
+	for _, newEphemeralResource := range p.EphemeralResources(ctx) {
+		e := newEphemeralResource()
+		metadata := &ephemeral.MetadataResponse{}
+		e.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "foxglove"}, metadata)
+		schema := &ephemeral.SchemaResponse{}
+		e.Schema(ctx, ephemeral.SchemaRequest{}, schema)
+		if diags := schema.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Errorf("ephemeral resource %s: %v", metadata.TypeName, diags)
+		}
+	}
+}
+
+func TestConfigureRetryMaxWait(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+	for value, wantError := range map[string]bool{"10s": false, "0s": true, "-1s": true} {
+		config := tfsdk.State{Schema: schemaResp.Schema}
+		config.Set(ctx, &FoxgloveProviderModel{
+			ApiKey:             types.StringValue("test"),
+			Endpoint:           types.StringNull(),
+			RequestTimeout:     types.StringNull(),
+			InsecureSkipVerify: types.BoolNull(),
+			CACertFile:         types.StringNull(),
+			ProxyURL:           types.StringNull(),
+			UserAgentSuffix:    types.StringNull(),
+			MaxRetries:         types.Int64Null(),
+			RetryMaxWait:       types.StringValue(value),
+		})
+
+		resp := &provider.ConfigureResponse{}
+		p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, resp)
+		if resp.Diagnostics.HasError() != wantError {
+			t.Errorf("retry_max_wait %q: expected error to be %v, got %v", value, wantError, resp.Diagnostics)
+		}
+	}
+}
+
+// newTestClient returns a client of an API served by handler, which does not
+// retry failed requests.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *foxglove.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := foxglove.NewClient("test")
+	client.BaseURL = server.URL
+	client.RetryPolicy.MaxRetries = 0
+	return client
+}
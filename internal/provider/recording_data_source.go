@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RecordingDataSource{}
+
+func NewRecordingDataSource() datasource.DataSource {
+	return &RecordingDataSource{}
+}
+
+// RecordingDataSource defines the data source implementation.
+type RecordingDataSource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// RecordingDataSourceModel describes the data source data model.
+type RecordingDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Path         types.String `tfsdk:"path"`
+	Size         types.Int64  `tfsdk:"size"`
+	MessageCount types.Int64  `tfsdk:"message_count"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	ImportedAt   types.String `tfsdk:"imported_at"`
+	Start        types.String `tfsdk:"start"`
+	End          types.String `tfsdk:"end"`
+	ImportStatus types.String `tfsdk:"import_status"`
+	DeviceId     types.String `tfsdk:"device_id"`
+	DeviceName   types.String `tfsdk:"device_name"`
+	SiteId       types.String `tfsdk:"site_id"`
+	SiteName     types.String `tfsdk:"site_name"`
+}
+
+func (d *RecordingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recording"
+}
+
+func (d *RecordingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := recordingAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The identifier of the recording.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Recording",
+		Attributes:          attributes,
+	}
+}
+
+// recordingAttributes returns the computed attributes describing a recording.
+func recordingAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The identifier of the recording.",
+		},
+		"path": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The file path of the recording.",
+		},
+		"size": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The size of the recording in bytes.",
+		},
+		"message_count": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of messages in the recording.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Creation time of the recording.",
+		},
+		"imported_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Time the recording was imported, empty if it was not imported.",
+		},
+		"start": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Timestamp of the earliest message in the recording.",
+		},
+		"end": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Timestamp of the latest message in the recording.",
+		},
+		"import_status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Import status of the recording, one of `none`, `pending`, `complete`, `error` or `failed`.",
+		},
+		"device_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The identifier of the device the recording belongs to.",
+		},
+		"device_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the device the recording belongs to.",
+		},
+		"site_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The identifier of the site the recording is stored on.",
+		},
+		"site_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the site the recording is stored on.",
+		},
+	}
+}
+
+func (d *RecordingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.foxgloveClient = foxgloveClient
+}
+
+func (d *RecordingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RecordingDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recording, err := d.foxgloveClient.GetRecording(ctx, data.Id.ValueString())
+	if foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("recording not found", fmt.Sprintf("No recording with id %q exists.", data.Id.ValueString()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read recording", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, recordingModel(recording))...)
+}
+
+// recordingModel converts an API recording into the data source model.
+func recordingModel(recording *foxglove.RecordingResponse) *RecordingDataSourceModel {
+	data := &RecordingDataSourceModel{
+		Id:           types.StringValue(recording.ID),
+		Path:         types.StringValue(recording.Path),
+		Size:         types.Int64Value(recording.Size),
+		MessageCount: types.Int64Value(recording.MessageCount),
+		CreatedAt:    types.StringValue(recording.CreatedAt),
+		ImportedAt:   types.StringValue(recording.ImportedAt),
+		Start:        types.StringValue(recording.Start),
+		End:          types.StringValue(recording.End),
+		ImportStatus: types.StringValue(recording.ImportStatus),
+		DeviceId:     types.StringValue(""),
+		DeviceName:   types.StringValue(""),
+		SiteId:       types.StringValue(""),
+		SiteName:     types.StringValue(""),
+	}
+	if recording.Device != nil {
+		data.DeviceId = types.StringValue(recording.Device.ID)
+		data.DeviceName = types.StringValue(recording.Device.Name)
+	}
+	if recording.Site != nil {
+		data.SiteId = types.StringValue(recording.Site.ID)
+		data.SiteName = types.StringValue(recording.Site.Name)
+	}
+	return data
+}
@@ -0,0 +1,357 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RecordingImportResource{}
+var _ resource.ResourceWithValidateConfig = &RecordingImportResource{}
+var _ resource.ResourceWithModifyPlan = &RecordingImportResource{}
+
+// defaultImportTimeout bounds the wait for an import unless timeout is set.
+const defaultImportTimeout = time.Hour
+
+func NewRecordingImportResource() resource.Resource {
+	return &RecordingImportResource{}
+}
+
+// RecordingImportResource defines the resource implementation.
+type RecordingImportResource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// RecordingImportResourceModel describes the resource data model.
+type RecordingImportResourceModel struct {
+	File         types.String `tfsdk:"file"`
+	DeviceId     types.String `tfsdk:"device_id"`
+	Key          types.String `tfsdk:"key"`
+	SourceSha256 types.String `tfsdk:"source_sha256"`
+	Timeout      types.String `tfsdk:"timeout"`
+	Id           types.String `tfsdk:"id"`
+	Path         types.String `tfsdk:"path"`
+	ImportStatus types.String `tfsdk:"import_status"`
+	Start        types.String `tfsdk:"start"`
+	End          types.String `tfsdk:"end"`
+}
+
+// fileSha256 returns the hex encoded SHA-256 checksum of the file at name,
+// without reading the whole file into memory.
+func fileSha256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (r *RecordingImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recording_import"
+}
+
+func (r *RecordingImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Recording import",
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path to the recording file to upload, for example an MCAP file. Its base name becomes the path of the recording. Changing it replaces the recording.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the device the recording belongs to. Changing it replaces the recording.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A unique key of the upload, which lets Foxglove recognize repeated uploads of the same file. Changing it replaces the recording.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_sha256": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "SHA-256 checksum of the file, hex encoded. Computed from the file if not set, so that a changed file replaces the recording. If set, for example to `filesha256(...)`, the file must match it.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultImportTimeout.String()),
+				MarkdownDescription: "How long to wait for the upload and import, such as `30m`. Defaults to `1h`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Opaque identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The path of the recording.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"import_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The import status of the recording.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"start": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp of the first message of the recording.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"end": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp of the last message of the recording.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RecordingImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RecordingImportResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Timeout.IsUnknown() {
+		parseDuration(&resp.Diagnostics, path.Root("timeout"), data.Timeout.ValueString(), defaultImportTimeout)
+	}
+}
+
+// ModifyPlan computes the checksum of the file unless it is configured, so
+// that a changed file replaces the recording. An imported file which has been
+// removed locally keeps its recording.
+func (r *RecordingImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// the recording is being destroyed
+		return
+	}
+
+	var plan, state RecordingImportResourceModel
+	var configured types.String
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_sha256"), &configured)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() || plan.File.IsUnknown() {
+		return
+	}
+
+	if configured.IsNull() {
+		sum, err := fileSha256(plan.File.ValueString())
+		if errors.Is(err, fs.ErrNotExist) && !req.State.Raw.IsNull() && plan.File.Equal(state.File) {
+			// the file is only needed to upload it again, e.g. on another machine
+			sum, err = state.SourceSha256.ValueString(), nil
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("file"), "Invalid recording file", err.Error())
+			return
+		}
+		plan.SourceSha256 = types.StringValue(sum)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+
+	if !req.State.Raw.IsNull() && !plan.SourceSha256.Equal(state.SourceSha256) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_sha256"))
+	}
+}
+
+func (r *RecordingImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.foxgloveClient = foxgloveClient
+}
+
+func (r *RecordingImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordingImportResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the checksum is verified at apply, since the file may have changed since
+	// the plan was made or the configured checksum may not match it
+	sum, err := fileSha256(data.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "failed to read recording file", err.Error())
+		return
+	}
+	if !data.SourceSha256.IsUnknown() && sum != data.SourceSha256.ValueString() {
+		resp.Diagnostics.AddAttributeError(path.Root("source_sha256"), "Recording file changed",
+			fmt.Sprintf("%s has checksum %s, expected %s. Plan again if the file changed since the plan was made.", data.File.ValueString(), sum, data.SourceSha256.ValueString()))
+		return
+	}
+	data.SourceSha256 = types.StringValue(sum)
+
+	timeout := parseDuration(&resp.Diagnostics, path.Root("timeout"), data.Timeout.ValueString(), defaultImportTimeout)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	file, err := os.Open(data.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "failed to read recording file", err.Error())
+		return
+	}
+	defer file.Close()
+
+	importCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	recording, err := r.foxgloveClient.ImportRecording(importCtx, foxglove.ImportRecordingRequest{
+		CreateUploadRequest: foxglove.CreateUploadRequest{
+			Filename: filepath.Base(data.File.ValueString()),
+			DeviceID: data.DeviceId.ValueString(),
+			Key:      data.Key.ValueString(),
+		},
+	}, file)
+	var importErr *foxglove.ImportError
+	if errors.As(err, &importErr) {
+		resp.Diagnostics.AddError("failed to import recording",
+			fmt.Sprintf("Foxglove could not import %s: %s. Check that the file is a valid recording, then taint or replace this resource to upload it again.", data.File.ValueString(), importErr.Error()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to import recording", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, recordingImportModel(recording, data))...)
+}
+
+func (r *RecordingImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordingImportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recording, err := r.foxgloveClient.GetRecording(ctx, data.Id.ValueString())
+	if foxglove.IsNotFound(err) {
+		// the recording was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read recording", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, recordingImportModel(recording, data))...)
+}
+
+// Update is only called with changes to timeout, since every other
+// configurable attribute requires replacement.
+func (r *RecordingImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecordingImportResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordingImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordingImportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.foxgloveClient.DeleteRecording(ctx, data.Id.ValueString())
+	if err != nil && !foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete recording", err.Error())
+		return
+	}
+}
+
+// recordingImportModel builds the state of an imported recording. The upload
+// settings are those of prior, since the api does not return them.
+func recordingImportModel(recording *foxglove.RecordingResponse, prior RecordingImportResourceModel) *RecordingImportResourceModel {
+	model := prior
+	model.Id = types.StringValue(recording.ID)
+	model.Path = types.StringValue(recording.Path)
+	model.ImportStatus = types.StringValue(recording.ImportStatus)
+	model.Start = types.StringValue(recording.Start)
+	model.End = types.StringValue(recording.End)
+	if recording.Device != nil && recording.Device.ID != "" {
+		model.DeviceId = types.StringValue(recording.Device.ID)
+	}
+	return &model
+}
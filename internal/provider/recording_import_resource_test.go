@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFileSha256(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "scene.mcap")
+	if err := os.WriteFile(name, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	sum, err := fileSha256(name)
+	if err != nil {
+		t.Fatalf("Failed to hash file: %v", err)
+	}
+	if sum != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+		t.Errorf("Unexpected checksum %s", sum)
+	}
+}
+
+func TestRecordingImportModel(t *testing.T) {
+	prior := RecordingImportResourceModel{
+		File:         types.StringValue("data/scene.mcap"),
+		DeviceId:     types.StringValue("dev_1"),
+		Key:          types.StringNull(),
+		SourceSha256: types.StringValue("abc"),
+		Timeout:      types.StringValue("1h0m0s"),
+	}
+
+	model := recordingImportModel(&foxglove.RecordingResponse{
+		ID:           "rec_1",
+		Path:         "scene.mcap",
+		ImportStatus: foxglove.ImportStatusComplete,
+		Device:       &foxglove.RecordingDevice{ID: "dev_1"},
+	}, prior)
+	if model.Id.ValueString() != "rec_1" || model.ImportStatus.ValueString() != foxglove.ImportStatusComplete {
+		t.Errorf("Unexpected recording %+v", model)
+	}
+	if !model.File.Equal(prior.File) || !model.SourceSha256.Equal(prior.SourceSha256) || !model.Key.IsNull() {
+		t.Errorf("expected the upload settings to be kept, got %+v", model)
+	}
+}
+
+func TestRecordingImportModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &RecordingImportResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "scene.mcap")
+	if err := os.WriteFile(existing, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(dir, "missing.mcap")
+	const helloSha256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+	testCases := map[string]struct {
+		stateFile   string
+		planFile    string
+		wantSha256  string
+		wantReplace bool
+		wantError   bool
+	}{
+		"unchanged":             {stateFile: existing, planFile: existing, wantSha256: helloSha256},
+		"content changed":       {stateFile: existing, planFile: existing, wantSha256: helloSha256, wantReplace: true},
+		"removed after import":  {stateFile: missing, planFile: missing, wantSha256: "abc"},
+		"missing with new path": {stateFile: existing, planFile: missing, wantError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			stateSha256 := helloSha256
+			if tc.wantReplace || tc.stateFile == missing {
+				stateSha256 = "abc"
+			}
+
+			model := RecordingImportResourceModel{
+				File:         types.StringValue(tc.stateFile),
+				DeviceId:     types.StringValue("dev_1"),
+				Key:          types.StringNull(),
+				SourceSha256: types.StringValue(stateSha256),
+				Timeout:      types.StringValue("1h0m0s"),
+				Id:           types.StringValue("rec_1"),
+				Path:         types.StringValue(filepath.Base(tc.stateFile)),
+				ImportStatus: types.StringValue(foxglove.ImportStatusComplete),
+				Start:        types.StringNull(),
+				End:          types.StringNull(),
+			}
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := state.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("failed to build state: %v", diags)
+			}
+
+			model.File = types.StringValue(tc.planFile)
+			model.SourceSha256 = types.StringNull()
+			config := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := config.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("failed to build config: %v", diags)
+			}
+			model.SourceSha256 = types.StringUnknown()
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("failed to build plan: %v", diags)
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw},
+				Plan:   plan,
+				State:  state,
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Fatalf("expected error to be %v, got %v", tc.wantError, resp.Diagnostics)
+			}
+			if tc.wantError {
+				return
+			}
+
+			var planned RecordingImportResourceModel
+			resp.Plan.Get(ctx, &planned)
+			if planned.SourceSha256.ValueString() != tc.wantSha256 {
+				t.Errorf("expected checksum %s, got %s", tc.wantSha256, planned.SourceSha256)
+			}
+			if replace := len(resp.RequiresReplace) > 0; replace != tc.wantReplace {
+				t.Errorf("expected replace to be %v, got %v", tc.wantReplace, replace)
+			}
+		})
+	}
+}
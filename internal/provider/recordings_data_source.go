@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RecordingsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &RecordingsDataSource{}
+
+// importStatuses are the import states a recording can be filtered by.
+var importStatuses = []string{
+	foxglove.ImportStatusNone,
+	foxglove.ImportStatusPending,
+	foxglove.ImportStatusComplete,
+	foxglove.ImportStatusError,
+	foxglove.ImportStatusFailed,
+}
+
+func NewRecordingsDataSource() datasource.DataSource {
+	return &RecordingsDataSource{}
+}
+
+// RecordingsDataSource defines the data source implementation.
+type RecordingsDataSource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// RecordingsDataSourceModel describes the data source data model.
+type RecordingsDataSourceModel struct {
+	DeviceId     types.String               `tfsdk:"device_id"`
+	DeviceName   types.String               `tfsdk:"device_name"`
+	Start        types.String               `tfsdk:"start"`
+	End          types.String               `tfsdk:"end"`
+	Path         types.String               `tfsdk:"path"`
+	ImportStatus types.String               `tfsdk:"import_status"`
+	SortBy       types.String               `tfsdk:"sort_by"`
+	SortOrder    types.String               `tfsdk:"sort_order"`
+	MaxResults   types.Int64                `tfsdk:"max_results"`
+	Ids          []string                   `tfsdk:"ids"`
+	Recordings   []RecordingDataSourceModel `tfsdk:"recordings"`
+}
+
+func (d *RecordingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recordings"
+}
+
+func (d *RecordingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Recordings",
+		Attributes: map[string]schema.Attribute{
+			"device_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return recordings of the device with this identifier.",
+			},
+			"device_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return recordings of the device with this name.",
+			},
+			"start": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return recordings containing data after this RFC 3339 timestamp.",
+			},
+			"end": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return recordings containing data before this RFC 3339 timestamp.",
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return recordings with this file path.",
+			},
+			"import_status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return recordings with this import status, one of `none`, `pending`, `complete`, `error` or `failed`.",
+			},
+			"sort_by": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Field to sort the recordings by, such as `start`.",
+			},
+			"sort_order": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Sort order, either `asc` or `desc`.",
+			},
+			"max_results": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of recordings to return. By default all matching recordings are returned.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Identifiers of the matching recordings.",
+			},
+			"recordings": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching recordings.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: recordingAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *RecordingsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data RecordingsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Start.IsUnknown() {
+		parseTimestamp(&resp.Diagnostics, path.Root("start"), data.Start.ValueString())
+	}
+	if !data.End.IsUnknown() {
+		parseTimestamp(&resp.Diagnostics, path.Root("end"), data.End.ValueString())
+	}
+
+	if !data.ImportStatus.IsNull() && !data.ImportStatus.IsUnknown() && !slices.Contains(importStatuses, data.ImportStatus.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("import_status"), "Invalid import_status",
+			fmt.Sprintf("import_status must be one of %s, got %q.", strings.Join(importStatuses, ", "), data.ImportStatus.ValueString()))
+	}
+
+	if !data.SortOrder.IsNull() && !data.SortOrder.IsUnknown() {
+		if sortOrder := data.SortOrder.ValueString(); sortOrder != "asc" && sortOrder != "desc" {
+			resp.Diagnostics.AddAttributeError(path.Root("sort_order"), "Invalid sort_order",
+				fmt.Sprintf("sort_order must be either \"asc\" or \"desc\", got %q.", sortOrder))
+		}
+	}
+
+	if !data.MaxResults.IsNull() && !data.MaxResults.IsUnknown() && data.MaxResults.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_results"), "Invalid max_results",
+			"max_results must be greater than zero.")
+	}
+}
+
+func (d *RecordingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.foxgloveClient = foxgloveClient
+}
+
+func (d *RecordingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RecordingsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := foxglove.RecordingFilter{
+		DeviceID:     data.DeviceId.ValueString(),
+		DeviceName:   data.DeviceName.ValueString(),
+		Start:        parseTimestamp(&resp.Diagnostics, path.Root("start"), data.Start.ValueString()),
+		End:          parseTimestamp(&resp.Diagnostics, path.Root("end"), data.End.ValueString()),
+		Path:         data.Path.ValueString(),
+		ImportStatus: data.ImportStatus.ValueString(),
+		SortBy:       data.SortBy.ValueString(),
+		SortOrder:    data.SortOrder.ValueString(),
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Ids = []string{}
+	data.Recordings = []RecordingDataSourceModel{}
+
+	opts := foxglove.PageOptions{MaxItems: int(data.MaxResults.ValueInt64())}
+	for recording, err := range d.foxgloveClient.AllRecordings(ctx, filter, opts) {
+		if err != nil {
+			resp.Diagnostics.AddError("failed to list recordings", err.Error())
+			return
+		}
+
+		data.Ids = append(data.Ids, recording.ID)
+		data.Recordings = append(data.Recordings, *recordingModel(&recording))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseTimestamp parses an optional RFC 3339 timestamp, returning the zero
+// time for an empty value.
+func parseTimestamp(diags *diag.Diagnostics, attributePath path.Path, value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		diags.AddAttributeError(attributePath, "Invalid timestamp",
+			fmt.Sprintf("Expected an RFC 3339 timestamp such as \"2024-01-01T00:00:00Z\", got %q.", value))
+	}
+	return timestamp
+}
@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &SiteResource{}
+var _ resource.ResourceWithImportState = &SiteResource{}
+var _ resource.ResourceWithValidateConfig = &SiteResource{}
+
+func NewSiteResource() resource.Resource {
+	return &SiteResource{}
+}
+
+// SiteResource defines the resource implementation.
+type SiteResource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// SiteResourceModel describes the resource data model.
+type SiteResourceModel struct {
+	Name                    types.String `tfsdk:"name"`
+	Type                    types.String `tfsdk:"type"`
+	RetainRecordingsSeconds types.Int64  `tfsdk:"retain_recordings_seconds"`
+	Url                     types.String `tfsdk:"url"`
+	Id                      types.String `tfsdk:"id"`
+}
+
+func (r *SiteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site"
+}
+
+func (r *SiteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Site",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the site.",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of the site, either `self-hosted` for a primary site or `edge` for an edge site. Changing the type replaces the site.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"retain_recordings_seconds": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "How long an edge site keeps recordings, in seconds. Removing it from the configuration keeps the current value.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the site, once it is deployed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Opaque identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SiteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SiteResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() {
+		return
+	}
+
+	siteType := data.Type.ValueString()
+	if siteType != foxglove.SiteTypeSelfHosted && siteType != foxglove.SiteTypeEdge {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid type",
+			fmt.Sprintf("type must be either %q or %q, got %q.", foxglove.SiteTypeSelfHosted, foxglove.SiteTypeEdge, siteType))
+	}
+
+	if !data.RetainRecordingsSeconds.IsNull() && !data.RetainRecordingsSeconds.IsUnknown() && siteType != foxglove.SiteTypeEdge {
+		resp.Diagnostics.AddAttributeError(path.Root("retain_recordings_seconds"), "Invalid retain_recordings_seconds",
+			"retain_recordings_seconds is only supported by edge sites.")
+	}
+}
+
+func (r *SiteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.foxgloveClient = foxgloveClient
+}
+
+func (r *SiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SiteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site, err := r.foxgloveClient.CreateSite(ctx, foxglove.CreateSiteRequest{
+		Name:                    data.Name.ValueString(),
+		Type:                    data.Type.ValueString(),
+		RetainRecordingsSeconds: optionalInt64(data.RetainRecordingsSeconds),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create site", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, siteModel(site))...)
+}
+
+func (r *SiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SiteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site, err := r.foxgloveClient.GetSite(ctx, data.Id.ValueString())
+	if foxglove.IsNotFound(err) {
+		// the site was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read site", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, siteModel(site))...)
+}
+
+func (r *SiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SiteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site, err := r.foxgloveClient.UpdateSite(ctx, data.Id.ValueString(), foxglove.UpdateSiteRequest{
+		Name:                    data.Name.ValueString(),
+		RetainRecordingsSeconds: optionalInt64(data.RetainRecordingsSeconds),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update site", err.Error())
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, siteModel(site))...)
+}
+
+func (r *SiteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SiteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.foxgloveClient.DeleteSite(ctx, data.Id.ValueString())
+	if err != nil && !foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete site", err.Error())
+		return
+	}
+}
+
+func (r *SiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// siteModel builds the state of a site.
+func siteModel(site *foxglove.SiteResponse) *SiteResourceModel {
+	return &SiteResourceModel{
+		Name:                    types.StringValue(site.Name),
+		Type:                    types.StringValue(site.Type),
+		RetainRecordingsSeconds: types.Int64PointerValue(site.RetainRecordingsSeconds),
+		Url:                     types.StringValue(site.URL),
+		Id:                      types.StringValue(site.ID),
+	}
+}
+
+// optionalInt64 returns nil for a null or unknown value, so that it is not sent.
+func optionalInt64(value types.Int64) *int64 {
+	if value.IsUnknown() {
+		return nil
+	}
+	return value.ValueInt64Pointer()
+}
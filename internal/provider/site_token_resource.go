@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &SiteTokenResource{}
+var _ resource.ResourceWithImportState = &SiteTokenResource{}
+var _ resource.ResourceWithValidateConfig = &SiteTokenResource{}
+
+func NewSiteTokenResource() resource.Resource {
+	return &SiteTokenResource{}
+}
+
+// SiteTokenResource defines the resource implementation.
+type SiteTokenResource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// SiteTokenResourceModel describes the resource data model.
+type SiteTokenResourceModel struct {
+	SiteId         types.String `tfsdk:"site_id"`
+	Id             types.String `tfsdk:"id"`
+	Token          types.String `tfsdk:"token"`
+	PgpKey         types.String `tfsdk:"pgp_key"`
+	EncryptedToken types.String `tfsdk:"encrypted_token"`
+	KeyFingerprint types.String `tfsdk:"key_fingerprint"`
+}
+
+func (r *SiteTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_token"
+}
+
+func (r *SiteTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Site token",
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The identifier of the site the token authenticates.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Opaque identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The site token. Empty if `pgp_key` is set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pgp_key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: pgpKeyDescription("token", "encrypted_token"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"encrypted_token": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The site token encrypted with `pgp_key`, base64 encoded",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fingerprint of the PGP key used to encrypt the site token",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SiteTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SiteTokenResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PgpKey.IsNull() && !data.PgpKey.IsUnknown() {
+		if _, err := readPGPKey(data.PgpKey.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("pgp_key"), "Invalid pgp_key", err.Error())
+		}
+	}
+}
+
+func (r *SiteTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.foxgloveClient = foxgloveClient
+}
+
+func (r *SiteTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SiteTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteToken, err := r.foxgloveClient.CreateSiteToken(ctx, foxglove.CreateSiteTokenRequest{
+		SiteID: data.SiteId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create site token", err.Error())
+		return
+	}
+
+	stored, ok := storeSecret(data.PgpKey, "site token", siteToken.Token, func() error {
+		return r.foxgloveClient.DeleteSiteToken(ctx, siteToken.ID)
+	}, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &SiteTokenResourceModel{
+		SiteId:         types.StringValue(siteToken.SiteID),
+		Id:             types.StringValue(siteToken.ID),
+		Token:          stored.Secret,
+		PgpKey:         data.PgpKey,
+		EncryptedToken: stored.Encrypted,
+		KeyFingerprint: stored.KeyFingerprint,
+	})...)
+}
+
+func (r *SiteTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SiteTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteToken, err := r.foxgloveClient.GetSiteToken(ctx, data.Id.ValueString())
+	if foxglove.IsNotFound(err) {
+		// the token was deleted outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read site token", err.Error())
+		return
+	}
+
+	// The token itself is only returned on creation
+	data.SiteId = types.StringValue(siteToken.SiteID)
+	data.Id = types.StringValue(siteToken.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes to the token, since every configurable
+// attribute requires replacement.
+func (r *SiteTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SiteTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SiteTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SiteTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.foxgloveClient.DeleteSiteToken(ctx, data.Id.ValueString())
+	if err != nil && !foxglove.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete site token", err.Error())
+		return
+	}
+}
+
+func (r *SiteTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
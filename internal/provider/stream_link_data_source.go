@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-foxglove-cloud/internal/foxglove"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &StreamLinkDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &StreamLinkDataSource{}
+
+// streamFormats are the output formats data can be streamed in.
+var streamFormats = []string{
+	foxglove.StreamFormatMCAP,
+	foxglove.StreamFormatBag,
+	foxglove.StreamFormatJSON,
+}
+
+func NewStreamLinkDataSource() datasource.DataSource {
+	return &StreamLinkDataSource{}
+}
+
+// StreamLinkDataSource defines the data source implementation.
+type StreamLinkDataSource struct {
+	foxgloveClient *foxglove.Client
+}
+
+// StreamLinkDataSourceModel describes the data source data model.
+type StreamLinkDataSourceModel struct {
+	DeviceId           types.String `tfsdk:"device_id"`
+	DeviceName         types.String `tfsdk:"device_name"`
+	RecordingId        types.String `tfsdk:"recording_id"`
+	Start              types.String `tfsdk:"start"`
+	End                types.String `tfsdk:"end"`
+	Topics             types.List   `tfsdk:"topics"`
+	OutputFormat       types.String `tfsdk:"output_format"`
+	IncludeAttachments types.Bool   `tfsdk:"include_attachments"`
+	Link               types.String `tfsdk:"link"`
+}
+
+func (d *StreamLinkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream_link"
+}
+
+func (d *StreamLinkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Stream link",
+		Attributes: map[string]schema.Attribute{
+			"device_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Stream data of the device with this identifier. Requires `start` and `end`.",
+			},
+			"device_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Stream data of the device with this name. Requires `start` and `end`.",
+			},
+			"recording_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Stream data of the recording with this identifier.",
+			},
+			"start": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Stream data from this RFC 3339 timestamp.",
+			},
+			"end": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Stream data until this RFC 3339 timestamp.",
+			},
+			"topics": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Only stream these topics. By default all topics are streamed.",
+			},
+			"output_format": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The format of the streamed data, one of `mcap`, `bag1` or `json`. Defaults to `mcap`.",
+			},
+			"include_attachments": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to include the attachments of MCAP recordings.",
+			},
+			"link": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The signed link to download the data from. Anyone with the link can download the data until it expires.",
+			},
+		},
+	}
+}
+
+func (d *StreamLinkDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data StreamLinkDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeviceId.IsUnknown() || data.DeviceName.IsUnknown() || data.RecordingId.IsUnknown() {
+		return
+	}
+
+	selections := 0
+	for _, value := range []types.String{data.DeviceId, data.DeviceName, data.RecordingId} {
+		if !value.IsNull() {
+			selections++
+		}
+	}
+	if selections != 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("recording_id"), "Invalid data selection",
+			"Exactly one of device_id, device_name or recording_id must be set.")
+	}
+	if data.RecordingId.IsNull() && (data.Start.IsNull() || data.End.IsNull()) {
+		resp.Diagnostics.AddAttributeError(path.Root("start"), "Missing time range",
+			"start and end must be set to stream data of a device.")
+	}
+
+	if !data.Start.IsUnknown() {
+		parseTimestamp(&resp.Diagnostics, path.Root("start"), data.Start.ValueString())
+	}
+	if !data.End.IsUnknown() {
+		parseTimestamp(&resp.Diagnostics, path.Root("end"), data.End.ValueString())
+	}
+
+	if !data.OutputFormat.IsNull() && !data.OutputFormat.IsUnknown() && !slices.Contains(streamFormats, data.OutputFormat.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("output_format"), "Invalid output_format",
+			fmt.Sprintf("output_format must be one of %s, got %q.", strings.Join(streamFormats, ", "), data.OutputFormat.ValueString()))
+	}
+}
+
+func (d *StreamLinkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	foxgloveClient, ok := req.ProviderData.(*foxglove.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *foxglove.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.foxgloveClient = foxgloveClient
+}
+
+func (d *StreamLinkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StreamLinkDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var topics []string
+	resp.Diagnostics.Append(data.Topics.ElementsAs(ctx, &topics, false)...)
+
+	outputFormat := data.OutputFormat.ValueString()
+	if outputFormat == "" {
+		outputFormat = foxglove.StreamFormatMCAP
+	}
+
+	streamReq := foxglove.StreamRequest{
+		DeviceID:           data.DeviceId.ValueString(),
+		DeviceName:         data.DeviceName.ValueString(),
+		RecordingID:        data.RecordingId.ValueString(),
+		Start:              parseTimestamp(&resp.Diagnostics, path.Root("start"), data.Start.ValueString()),
+		End:                parseTimestamp(&resp.Diagnostics, path.Root("end"), data.End.ValueString()),
+		Topics:             topics,
+		OutputFormat:       outputFormat,
+		IncludeAttachments: data.IncludeAttachments.ValueBool(),
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stream, err := d.foxgloveClient.StreamData(ctx, streamReq)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to request stream link", err.Error())
+		return
+	}
+
+	data.Link = types.StringValue(stream.Link)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}